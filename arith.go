@@ -0,0 +1,137 @@
+package fp
+
+import (
+	"math"
+	"math/big"
+)
+
+// AddChecked a + b, ErrOverflow if the result does not fit in N, for floats
+// if it overflows to an infinity.
+func AddChecked[N Real](a, b N) (N, error) {
+	var s = a + b
+	if IsFloat[N]() {
+		return s, overflowInf(s, a, b)
+	}
+
+	return s, If((b > 0 && s < a) || (b < 0 && s > a), Lazy(ErrOverflow), Zero[error])
+}
+
+// SubChecked a - b, ErrOverflow if the result does not fit in N, for floats
+// if it overflows to an infinity.
+func SubChecked[N Real](a, b N) (N, error) {
+	var d = a - b
+	if IsFloat[N]() {
+		return d, overflowInf(d, a, b)
+	}
+
+	return d, If((b > 0 && d > a) || (b < 0 && d < a), Lazy(ErrOverflow), Zero[error])
+}
+
+// MulChecked a * b, ErrOverflow if the result does not fit in N, for floats
+// if it overflows to an infinity.
+func MulChecked[N Real](a, b N) (N, error) {
+	var p = a * b
+	if IsFloat[N]() {
+		return p, overflowInf(p, a, b)
+	}
+
+	if a == 0 || b == 0 {
+		return p, nil
+	}
+
+	// MinInt * -1 wraps to MinInt, which the division does not catch.
+	var minusOne = Zero[N]() - 1
+	return p, If(p/b != a || (b == minusOne && p == a), Lazy(ErrOverflow), Zero[error])
+}
+
+// SumChecked of values, ErrOverflow at the first overflow, see AddChecked.
+func SumChecked[N Real](n ...N) (N, error) {
+	return SumCheckedFrom(iterate(n))
+}
+
+// SumCheckedFrom of iterated, ErrOverflow at the first overflow, see AddChecked.
+func SumCheckedFrom[N Real](n Next[N]) (N, error) {
+	return FoldLeftErr(n, Zero[N](), AddChecked[N])
+}
+
+// SumWide of integers accumulated into a big.Int, it never overflows.
+func SumWide[N Integer](n ...N) *big.Int {
+	return SumWideFrom(iterate(n))
+}
+
+// SumWideFrom of iterated integers accumulated into a big.Int, it never
+// overflows.
+func SumWideFrom[N Integer](n Next[N]) *big.Int {
+	var sum, x = new(big.Int), new(big.Int)
+	Loop(n, func(m N) {
+		if m < 0 {
+			sum.Add(sum, x.SetInt64(int64(m)))
+			return
+		}
+
+		sum.Add(sum, x.SetUint64(uint64(m)))
+	})
+
+	return sum
+}
+
+// SumKahan of floats accumulated into a float64 with Neumaier's compensated
+// summation, the rounding error does not grow with the number of values.
+func SumKahan[F Float](n ...F) float64 {
+	return SumKahanFrom(iterate(n))
+}
+
+// SumKahanFrom of iterated floats, see SumKahan.
+func SumKahanFrom[F Float](n Next[F]) float64 {
+	var sum, c float64
+	Loop(n, func(m F) {
+		var x = float64(m)
+		var t = sum + x
+		if math.Abs(sum) >= math.Abs(x) {
+			c += (sum - t) + x
+		} else {
+			c += (x - t) + sum
+		}
+
+		sum = t
+	})
+
+	return sum + c
+}
+
+// Product of values, 1 if there is no value.
+func Product[N Number](n ...N) N {
+	return ProductFrom(iterate(n))
+}
+
+// ProductFrom of iterated, 1 if the iterator is empty.
+func ProductFrom[N Number](n Next[N]) N {
+	return FoldLeft(n, N(1), func(p, m N) N { return p * m })
+}
+
+// Average of values as a float64, false if there is no value. It is computed
+// as a running mean, so it does not overflow.
+func Average[N Real](n ...N) (float64, bool) {
+	return AverageFrom(iterate(n))
+}
+
+// AverageFrom of iterated as a float64, false if the iterator is empty.
+func AverageFrom[N Real](n Next[N]) (float64, bool) {
+	var mean, count = 0.0, 0
+	Loop(n, func(m N) {
+		count++
+		mean += (float64(m) - mean) / float64(count)
+	})
+
+	return mean, count > 0
+}
+
+// IsFloat check if N is a float type.
+func IsFloat[N Real]() bool {
+	return N(1)/2 != 0
+}
+
+func overflowInf[N Real](r, a, b N) error {
+	var inf = math.IsInf(float64(r), 0) && Not(math.IsInf(float64(a), 0) || math.IsInf(float64(b), 0))
+	return If(inf, Lazy(ErrOverflow), Zero[error])
+}
@@ -0,0 +1,89 @@
+package bloom
+
+// Package bloom implements a Bloom filter over the murmur3 hash of str.Hash,
+// and a probabilistic deduplication of iterators built on it.
+import (
+	"math"
+	"math/bits"
+
+	"github.com/molikatty/fp"
+	"github.com/molikatty/fp/str"
+)
+
+// Filter a Bloom filter of strings, Has never misses an added string, and
+// wrongly reports a string that was not added at the configured rate.
+//
+// Warning: not concurrent-safe.
+type Filter struct {
+	bits []uint64
+	m, k uint64
+}
+
+// New make a filter sized for 'n' strings with a false-positive rate of 'p',
+// the rate grows once more than 'n' strings are added.
+func New(n int, p float64) *Filter {
+	if n < 1 || p <= 0 || p >= 1 {
+		panic("bloom: expected n > 0 and 0 < p < 1")
+	}
+
+	var m = math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2))
+	var k = math.Max(1, math.Round(m/float64(n)*math.Ln2))
+
+	// a power of two, so that the probes of an odd h2 are all distinct.
+	var size = uint64(1) << bits.Len64(uint64(m)-1)
+	return &Filter{
+		bits: make([]uint64, (size+63)/64),
+		m:    size,
+		k:    uint64(k),
+	}
+}
+
+// Add a string to the filter.
+func (f *Filter) Add(s string) {
+	f.each(s, func(i uint64) bool {
+		f.bits[i/64] |= 1 << (i % 64)
+		return true
+	})
+}
+
+// Has check if a string may have been added.
+func (f *Filter) Has(s string) (ok bool) {
+	ok = true
+	f.each(s, func(i uint64) bool {
+		ok = f.bits[i/64]&(1<<(i%64)) != 0
+		return ok
+	})
+
+	return
+}
+
+// TestAndAdd add a string and report whether it may have been added before.
+func (f *Filter) TestAndAdd(s string) bool {
+	var has = f.Has(s)
+	f.Add(s)
+	return has
+}
+
+// each derive the k bit positions from the two halves of the 64-bit hash
+// (Kirsch-Mitzenmacher double hashing). m is a power of two and h2 is made
+// odd, so the k probes never collapse onto one bit.
+func (f *Filter) each(s string, fn func(uint64) bool) {
+	var h = str.Hash[uint64](s)
+	var h1, h2 = h & math.MaxUint32, h>>32 | 1
+	for i := uint64(0); i < f.k; i++ {
+		if !fn((h1 + i*h2) % f.m) {
+			return
+		}
+	}
+}
+
+// DistinctApprox keep only the first value of each key with bounded memory,
+// a value is wrongly dropped as a duplicate at a rate of about 'p' for the
+// first 'n' distinct keys, see New.
+// this function is lazy.
+func DistinctApprox[E any](next fp.Next[E], key func(E) string, n int, p float64) fp.Next[E] {
+	var f = New(n, p)
+	return fp.Filter(next, func(e E) bool {
+		return !f.TestAndAdd(key(e))
+	})
+}
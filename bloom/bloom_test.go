@@ -0,0 +1,34 @@
+package bloom
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/molikatty/fp"
+)
+
+func TestExample(t *testing.T) {
+	t.Run("Filter", func(t *testing.T) {
+		var f = New(1000, 0.01)
+		for i := 0; i < 1000; i++ {
+			f.Add(strconv.Itoa(i))
+		}
+
+		var misses, wrong int
+		for i := 0; i < 1000; i++ {
+			misses += fp.If(f.Has(strconv.Itoa(i)), fp.Zero[int], fp.Lazy(1))
+			wrong += fp.If(f.Has(strconv.Itoa(-i-1)), fp.Lazy(1), fp.Zero[int])
+		}
+
+		if misses != 0 || wrong > 30 {
+			t.Error("unexpected rate", misses, wrong)
+		}
+	})
+
+	t.Run("DistinctApprox", func(t *testing.T) {
+		var mod = fp.Map[int](fp.Range(10000), func(i int) int { return i % 100 })
+		if n := fp.Count(DistinctApprox(mod, strconv.Itoa, 100, 0.001)); n < 95 || n > 100 {
+			t.Error("Not equal", n)
+		}
+	})
+}
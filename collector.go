@@ -0,0 +1,61 @@
+package fp
+
+import "sync"
+
+// Collector a terminal operation of an iterator, a mutable or immutable
+// accumulation of type A is created by Supply, fed every value by Accumulate,
+// and turned into the result R by Finish.
+type Collector[E, A, R any] interface {
+	Supply() A
+	Accumulate(A, E) A
+	Finish(A) R
+}
+
+// Combiner optionally implemented by a Collector whose accumulations can be
+// merged, which allows it to be used by CollectPar.
+type Combiner[A any] interface {
+	Combine(A, A) A
+}
+
+// Collect the values of the iterator with the collector.
+func Collect[E, A, R any](next Next[E], c Collector[E, A, R]) R {
+	var acc = c.Supply()
+	Loop(next, func(e E) { acc = c.Accumulate(acc, e) })
+
+	return c.Finish(acc)
+}
+
+// CollectPar like Collect, but accumulate on 'workers' goroutines and merge the
+// accumulations with Combine, the values reach the collector in no particular
+// order. If the collector is not a Combiner, it falls back to Collect.
+func CollectPar[E, A, R any](next Next[E], workers int, c Collector[E, A, R]) R {
+	var combiner, ok = c.(Combiner[A])
+	if Not(ok) || workers < 2 {
+		return Collect(next, c)
+	}
+
+	var (
+		lock   sync.Mutex
+		accs   = make([]A, 0, workers)
+		locked = Lock(next)
+	)
+
+	run, wait := Async().Expand()
+	for i := 0; i < workers; i++ {
+		run(func() {
+			var acc = c.Supply()
+			Loop(locked, func(e E) { acc = c.Accumulate(acc, e) })
+
+			lock.Lock()
+			accs = append(accs, acc)
+			lock.Unlock()
+		})
+	}
+
+	wait()
+	for i := 1; i < len(accs); i++ {
+		accs[0] = combiner.Combine(accs[0], accs[i])
+	}
+
+	return c.Finish(accs[0])
+}
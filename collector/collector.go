@@ -0,0 +1,232 @@
+package collector
+
+// Package collector implements common fp.Collector for fp.Collect.
+//
+//	byCity := fp.Collect(users, collector.GroupingBy(city, collector.Counting[User]()))
+import (
+	"github.com/molikatty/fp"
+	"github.com/molikatty/fp/set"
+	"github.com/molikatty/fp/str"
+)
+
+// Func a collector built from functions, it implements fp.Collector. Use
+// WithCombine to make it usable in parallel by fp.CollectPar.
+type Func[E, A, R any] struct {
+	supply     func() A
+	accumulate func(A, E) A
+	finish     func(A) R
+}
+
+// Combining a Func that merges accumulations, it implements fp.Combiner.
+type Combining[E, A, R any] struct {
+	Func[E, A, R]
+	combine func(A, A) A
+}
+
+var _ fp.Combiner[int] = Combining[int, int, int]{}
+
+// New create a collector from functions.
+func New[E, A, R any](supply func() A, accumulate func(A, E) A, finish func(A) R) Func[E, A, R] {
+	return Func[E, A, R]{supply, accumulate, finish}
+}
+
+// WithCombine return a copy of the collector that merges accumulations with
+// 'combine', required by fp.CollectPar.
+func (f Func[E, A, R]) WithCombine(combine func(A, A) A) Combining[E, A, R] {
+	return Combining[E, A, R]{f, combine}
+}
+
+func (f Func[E, A, R]) Supply() A {
+	return f.supply()
+}
+
+func (f Func[E, A, R]) Accumulate(a A, e E) A {
+	return f.accumulate(a, e)
+}
+
+func (f Func[E, A, R]) Finish(a A) R {
+	return f.finish(a)
+}
+
+// Combine the accumulations.
+func (c Combining[E, A, R]) Combine(a, b A) A {
+	return c.combine(a, b)
+}
+
+// ToSlice collect the values into a slice.
+func ToSlice[E any]() fp.Collector[E, []E, []E] {
+	return New(
+		func() []E { return make([]E, 0) },
+		func(s []E, e E) []E { return append(s, e) },
+		fp.Id[[]E],
+	).WithCombine(func(a, b []E) []E { return append(a, b...) })
+}
+
+// ToMap collect the values into a map, 'merge' resolves the values of a
+// duplicate key, the last value wins if it is nil.
+func ToMap[E any, K comparable, V any](key func(E) K, value func(E) V, merge func(V, V) V) fp.Collector[E, map[K]V, map[K]V] {
+	var put = func(m map[K]V, k K, v V) map[K]V {
+		if old, ok := m[k]; ok && merge != nil {
+			v = merge(old, v)
+		}
+
+		m[k] = v
+		return m
+	}
+
+	return New(
+		func() map[K]V { return make(map[K]V) },
+		func(m map[K]V, e E) map[K]V { return put(m, key(e), value(e)) },
+		fp.Id[map[K]V],
+	).WithCombine(func(a, b map[K]V) map[K]V {
+		for k, v := range b {
+			a = put(a, k, v)
+		}
+
+		return a
+	})
+}
+
+// ToSet collect the values into a set.
+func ToSet[U set.Safe | set.Unsafe, K comparable]() fp.Collector[K, set.Set[K], set.Set[K]] {
+	return New(
+		func() set.Set[K] { return set.Of[U, K]() },
+		func(s set.Set[K], k K) set.Set[K] {
+			s.Add(k)
+			return s
+		},
+		fp.Id[set.Set[K]],
+	).WithCombine(func(a, b set.Set[K]) set.Set[K] {
+		a.Adds(b)
+		return a
+	})
+}
+
+// GroupingBy group the values by 'key', the values of each group are collected
+// with 'downstream'. It is usable by fp.CollectPar if 'downstream' is.
+//
+//	GroupingBy(city, ToSlice[User]()) // map[string][]User
+func GroupingBy[E any, K comparable, A, R any](key func(E) K, downstream fp.Collector[E, A, R]) fp.Collector[E, map[K]A, map[K]R] {
+	var group = New(
+		func() map[K]A { return make(map[K]A) },
+		func(m map[K]A, e E) map[K]A {
+			var k = key(e)
+			acc, ok := m[k]
+			if fp.Not(ok) {
+				acc = downstream.Supply()
+			}
+
+			m[k] = downstream.Accumulate(acc, e)
+			return m
+		},
+		func(m map[K]A) map[K]R {
+			var r = make(map[K]R, len(m))
+			for k, a := range m {
+				r[k] = downstream.Finish(a)
+			}
+
+			return r
+		},
+	)
+
+	var inner, ok = downstream.(fp.Combiner[A])
+	if fp.Not(ok) {
+		return group
+	}
+
+	return group.WithCombine(func(a, b map[K]A) map[K]A {
+		for k, acc := range b {
+			if old, ok := a[k]; ok {
+				acc = inner.Combine(old, acc)
+			}
+
+			a[k] = acc
+		}
+
+		return a
+	})
+}
+
+// PartitioningBy split the values into the groups 'true' and 'false' by
+// 'pred', both groups are always present in the result.
+func PartitioningBy[E, A, R any](pred func(E) bool, downstream fp.Collector[E, A, R]) fp.Collector[E, map[bool]A, map[bool]R] {
+	var groups = GroupingBy(pred, downstream)
+	var partition = New(
+		func() map[bool]A {
+			return map[bool]A{true: downstream.Supply(), false: downstream.Supply()}
+		},
+		groups.Accumulate,
+		groups.Finish,
+	)
+
+	var inner, ok = groups.(fp.Combiner[map[bool]A])
+	if fp.Not(ok) {
+		return partition
+	}
+
+	return partition.WithCombine(inner.Combine)
+}
+
+// Joining concatenate the strings, separated by 'sep' and wrapped by
+// 'prefix' and 'suffix'.
+func Joining(sep, prefix, suffix string) fp.Collector[string, []string, string] {
+	return New(
+		func() []string { return make([]string, 0) },
+		func(s []string, e string) []string { return append(s, e) },
+		func(s []string) string { return str.Cat(prefix, str.Join(sep, s...), suffix) },
+	).WithCombine(func(a, b []string) []string { return append(a, b...) })
+}
+
+// Counting count the values.
+func Counting[E any]() fp.Collector[E, int, int] {
+	return New(
+		fp.Zero[int],
+		func(n int, _ E) int { return n + 1 },
+		fp.Id[int],
+	).WithCombine(func(a, b int) int { return a + b })
+}
+
+// Summary statistics of numbers, Min and Max are zero if Count is zero.
+type Summary[N fp.Integer | fp.Float] struct {
+	Count         int
+	Sum, Min, Max N
+}
+
+// Average of the numbers, 0 if Count is zero.
+func (s Summary[N]) Average() float64 {
+	return fp.If(s.Count == 0, fp.Zero[float64], func() float64 {
+		return float64(s.Sum) / float64(s.Count)
+	})
+}
+
+// Summarizing compute count, sum, min, max and average of the numbers.
+func Summarizing[N fp.Integer | fp.Float]() fp.Collector[N, Summary[N], Summary[N]] {
+	var add = func(s Summary[N], n N) Summary[N] {
+		if s.Count == 0 || n < s.Min {
+			s.Min = n
+		}
+
+		if s.Count == 0 || n > s.Max {
+			s.Max = n
+		}
+
+		s.Count++
+		s.Sum += n
+		return s
+	}
+
+	return New(fp.Zero[Summary[N]], add, fp.Id[Summary[N]]).WithCombine(func(a, b Summary[N]) Summary[N] {
+		switch {
+		case a.Count == 0:
+			return b
+		case b.Count == 0:
+			return a
+		}
+
+		a.Min = fp.If(b.Min < a.Min, fp.Lazy(b.Min), fp.Lazy(a.Min))
+		a.Max = fp.If(b.Max > a.Max, fp.Lazy(b.Max), fp.Lazy(a.Max))
+		a.Count += b.Count
+		a.Sum += b.Sum
+		return a
+	})
+}
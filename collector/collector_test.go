@@ -0,0 +1,84 @@
+package collector
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/molikatty/fp"
+	"github.com/molikatty/fp/set"
+	"github.com/molikatty/fp/slice"
+)
+
+func TestExample(t *testing.T) {
+	var words = slice.Of("apple", "avocado", "banana", "blueberry", "cherry")
+	var first = func(s string) byte { return s[0] }
+
+	t.Run("ToSlice", func(t *testing.T) {
+		if s := fp.Collect(fp.Range(3), ToSlice[int]()); !reflect.DeepEqual(s, []int{0, 1, 2}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("ToMap", func(t *testing.T) {
+		var m = fp.Collect(slice.Iter(words), ToMap(first, func(string) int { return 1 }, func(a, b int) int {
+			return a + b
+		}))
+
+		if !reflect.DeepEqual(m, map[byte]int{'a': 2, 'b': 2, 'c': 1}) {
+			t.Error("Not equal", m)
+		}
+	})
+
+	t.Run("ToSet", func(t *testing.T) {
+		var s = fp.Collect(slice.Iter(slice.Of(1, 2, 2, 3)), ToSet[set.Unsafe, int]())
+		if !s.Equal(set.Of[set.Unsafe](1, 2, 3)) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("GroupingBy", func(t *testing.T) {
+		var m = fp.Collect(slice.Iter(words), GroupingBy(first, Joining(",", "[", "]")))
+		if !reflect.DeepEqual(m, map[byte]string{'a': "[apple,avocado]", 'b': "[banana,blueberry]", 'c': "[cherry]"}) {
+			t.Error("Not equal", m)
+		}
+	})
+
+	t.Run("PartitioningBy", func(t *testing.T) {
+		var m = fp.Collect(fp.Range(5), PartitioningBy(func(i int) bool { return i > 5 }, Counting[int]()))
+		if !reflect.DeepEqual(m, map[bool]int{true: 0, false: 5}) {
+			t.Error("Not equal", m)
+		}
+	})
+
+	t.Run("Summarizing", func(t *testing.T) {
+		var s = fp.Collect(slice.Iter(slice.Of(3, 1, 4, 1, 5)), Summarizing[int]())
+		if s != (Summary[int]{Count: 5, Sum: 14, Min: 1, Max: 5}) || s.Average() != 2.8 {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("CollectParFallback", func(t *testing.T) {
+		var sum fp.Collector[int, int, int] = New(fp.Zero[int], func(a, e int) int { return a + e }, fp.Id[int])
+		if n := fp.CollectPar(fp.Range(100), 4, sum); n != 4950 {
+			t.Error("Not equal", n)
+		}
+
+		var m = fp.CollectPar(fp.Range(10), 4, PartitioningBy(func(i int) bool { return i < 3 }, sum))
+		if !reflect.DeepEqual(m, map[bool]int{true: 3, false: 42}) {
+			t.Error("Not equal", m)
+		}
+	})
+
+	t.Run("CollectPar", func(t *testing.T) {
+		var m = fp.CollectPar(fp.Range(1000), 4, GroupingBy(func(i int) bool { return i%2 == 0 }, Summarizing[int]()))
+		if m[true].Count != 500 || m[false].Sum != 250000 || m[false].Max != 999 {
+			t.Error("Not equal", m)
+		}
+
+		var s = fp.CollectPar(fp.Map[string](fp.Range(100), strconv.Itoa), 4, ToSlice[string]())
+		if len(s) != 100 {
+			t.Error("Not equal", len(s))
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package fp
+
+import "context"
+
+// WithContext stop the iterator once the context is done, the reason can be
+// obtained from ctx.Err(). Wrap the source of a pipeline, so that Map, Filter,
+// Merge and others built on it stop as well.
+// this function is lazy.
+//
+// Warning: a call of the wrapped iterator that is already running is not
+// interrupted.
+func WithContext[E any](ctx context.Context, next Next[E]) Next[E] {
+	return func() (E, bool) {
+		if ctx.Err() != nil {
+			return Zero[E](), false
+		}
+
+		return next()
+	}
+}
+
+// ForEachCtx like ForEach, but stop and return ctx.Err() when the context is done.
+func ForEachCtx[E any](ctx context.Context, next Next[E], fn func(E) bool) error {
+	next, err := cut(ctx, next).Expand()
+	ForEach(next, fn)
+	return err()
+}
+
+// LoopCtx like Loop, but stop and return ctx.Err() when the context is done.
+func LoopCtx[E any](ctx context.Context, next Next[E], fn func(E)) error {
+	next, err := cut(ctx, next).Expand()
+	Loop(next, fn)
+	return err()
+}
+
+// SliceCtx like Slice, return the values collected so far and ctx.Err()
+// when the context is done.
+func SliceCtx[E any](ctx context.Context, next Next[E]) ([]E, error) {
+	next, err := cut(ctx, next).Expand()
+	var slice = Slice(next)
+	return slice, err()
+}
+
+// KVCtx like KV, return the pairs collected so far and ctx.Err() when the
+// context is done.
+func KVCtx[K comparable, V any](ctx context.Context, next Next[Pairs[K, V]]) (map[K]V, error) {
+	next, err := cut(ctx, next).Expand()
+	var m = KV(next)
+	return m, err()
+}
+
+// ReduceCtx like Reduce, return the value reduced so far and ctx.Err() when
+// the context is done.
+func ReduceCtx[E any](ctx context.Context, next Next[E], fn func(E, E) E) (E, error) {
+	next, err := cut(ctx, next).Expand()
+	var poly = Reduce(next, fn)
+	return poly, err()
+}
+
+// cut like WithContext, the error is ctx.Err() only if the context stopped
+// the iterator, not if it ended before.
+func cut[E any](ctx context.Context, next Next[E]) Pairs[Next[E], func() error] {
+	var err error
+	return Pair(Next[E](func() (E, bool) {
+		if err = ctx.Err(); err != nil {
+			return Zero[E](), false
+		}
+
+		return next()
+	}), func() error { return err })
+}
+
+// ChanCtx like Chan, but the goroutine stops sending and closes the channel
+// when the context is done.
+func ChanCtx[E any](ctx context.Context, next Next[E], bufcap int) chan E {
+	var channel = make(chan E, bufcap)
+	go func() {
+		defer close(channel)
+		ForEach(WithContext(ctx, next), func(t E) bool {
+			select {
+			case channel <- t:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return channel
+}
@@ -0,0 +1,74 @@
+package fp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCtx(t *testing.T) {
+	t.Run("WithContext", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var next = Map[int](WithContext(ctx, Iota[int]()), func(i int) int {
+			if i == 3 {
+				cancel()
+			}
+
+			return i * 2
+		})
+
+		if s := Slice(next); !reflect.DeepEqual(s, []int{0, 2, 4, 6}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("SliceCtx", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		var slow = Map[int](Iota[int](), func(i int) int {
+			time.Sleep(time.Millisecond)
+			return i
+		})
+
+		s, err := SliceCtx(ctx, slow)
+		if !errors.Is(err, context.DeadlineExceeded) || len(s) == 0 {
+			t.Error("expected deadline", err, len(s))
+		}
+	})
+
+	t.Run("ReduceCtx", func(t *testing.T) {
+		n, err := ReduceCtx(context.Background(), Range(5), func(a, b int) int {
+			return a + b
+		})
+
+		if err != nil || n != 10 {
+			t.Error("Not equal", n, err)
+		}
+	})
+
+	t.Run("ForEachCtx", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var err = ForEachCtx(ctx, Range(5), func(i int) bool {
+			cancel()
+			return false
+		})
+
+		if err != nil {
+			t.Error("the context did not stop the iterator", err)
+		}
+	})
+
+	t.Run("ChanCtx", func(t *testing.T) {
+		noLeak(t, func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			var ch = ChanCtx(ctx, Iota[int](), 0)
+			<-ch
+			cancel()
+			for range ch {
+			}
+		})
+	})
+}
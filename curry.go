@@ -0,0 +1,248 @@
+// Code generated by internal/gen/curry; DO NOT EDIT.
+
+package fp
+
+// Curry2 turn a function of two arguments into a chain of functions of one
+// argument.
+func Curry2[A, B, R any](fn func(A, B) R) func(A) func(B) R {
+	return func(a A) func(B) R {
+		return func(b B) R {
+			return fn(a, b)
+		}
+	}
+}
+
+// Uncurry2 is the inverse of Curry2.
+func Uncurry2[A, B, R any](fn func(A) func(B) R) func(A, B) R {
+	return func(a A, b B) R {
+		return fn(a)(b)
+	}
+}
+
+// Partial2 fix the first argument of 'fn'.
+func Partial2[A, B, R any](fn func(A, B) R, a A) func(B) R {
+	return func(b B) R {
+		return fn(a, b)
+	}
+}
+
+// PartialRight2 fix the last argument of 'fn'.
+func PartialRight2[A, B, R any](fn func(A, B) R, b B) func(A) R {
+	return func(a A) R {
+		return fn(a, b)
+	}
+}
+
+// Flip2 swap the first two arguments of 'fn'.
+func Flip2[A, B, R any](fn func(A, B) R) func(B, A) R {
+	return func(b B, a A) R {
+		return fn(a, b)
+	}
+}
+
+// Bind2 fix all the arguments of 'fn', this function is lazy.
+func Bind2[A, B, R any](fn func(A, B) R, a A, b B) func() R {
+	return func() R {
+		return fn(a, b)
+	}
+}
+
+// Curry3 turn a function of three arguments into a chain of functions of one
+// argument.
+func Curry3[A, B, C, R any](fn func(A, B, C) R) func(A) func(B) func(C) R {
+	return func(a A) func(B) func(C) R {
+		return func(b B) func(C) R {
+			return func(c C) R {
+				return fn(a, b, c)
+			}
+		}
+	}
+}
+
+// Uncurry3 is the inverse of Curry3.
+func Uncurry3[A, B, C, R any](fn func(A) func(B) func(C) R) func(A, B, C) R {
+	return func(a A, b B, c C) R {
+		return fn(a)(b)(c)
+	}
+}
+
+// Partial3 fix the first argument of 'fn'.
+func Partial3[A, B, C, R any](fn func(A, B, C) R, a A) func(B, C) R {
+	return func(b B, c C) R {
+		return fn(a, b, c)
+	}
+}
+
+// PartialRight3 fix the last argument of 'fn'.
+func PartialRight3[A, B, C, R any](fn func(A, B, C) R, c C) func(A, B) R {
+	return func(a A, b B) R {
+		return fn(a, b, c)
+	}
+}
+
+// Flip3 swap the first two arguments of 'fn'.
+func Flip3[A, B, C, R any](fn func(A, B, C) R) func(B, A, C) R {
+	return func(b B, a A, c C) R {
+		return fn(a, b, c)
+	}
+}
+
+// Bind3 fix all the arguments of 'fn', this function is lazy.
+func Bind3[A, B, C, R any](fn func(A, B, C) R, a A, b B, c C) func() R {
+	return func() R {
+		return fn(a, b, c)
+	}
+}
+
+// Curry4 turn a function of four arguments into a chain of functions of one
+// argument.
+func Curry4[A, B, C, D, R any](fn func(A, B, C, D) R) func(A) func(B) func(C) func(D) R {
+	return func(a A) func(B) func(C) func(D) R {
+		return func(b B) func(C) func(D) R {
+			return func(c C) func(D) R {
+				return func(d D) R {
+					return fn(a, b, c, d)
+				}
+			}
+		}
+	}
+}
+
+// Uncurry4 is the inverse of Curry4.
+func Uncurry4[A, B, C, D, R any](fn func(A) func(B) func(C) func(D) R) func(A, B, C, D) R {
+	return func(a A, b B, c C, d D) R {
+		return fn(a)(b)(c)(d)
+	}
+}
+
+// Partial4 fix the first argument of 'fn'.
+func Partial4[A, B, C, D, R any](fn func(A, B, C, D) R, a A) func(B, C, D) R {
+	return func(b B, c C, d D) R {
+		return fn(a, b, c, d)
+	}
+}
+
+// PartialRight4 fix the last argument of 'fn'.
+func PartialRight4[A, B, C, D, R any](fn func(A, B, C, D) R, d D) func(A, B, C) R {
+	return func(a A, b B, c C) R {
+		return fn(a, b, c, d)
+	}
+}
+
+// Flip4 swap the first two arguments of 'fn'.
+func Flip4[A, B, C, D, R any](fn func(A, B, C, D) R) func(B, A, C, D) R {
+	return func(b B, a A, c C, d D) R {
+		return fn(a, b, c, d)
+	}
+}
+
+// Bind4 fix all the arguments of 'fn', this function is lazy.
+func Bind4[A, B, C, D, R any](fn func(A, B, C, D) R, a A, b B, c C, d D) func() R {
+	return func() R {
+		return fn(a, b, c, d)
+	}
+}
+
+// Curry5 turn a function of five arguments into a chain of functions of one
+// argument.
+func Curry5[A, B, C, D, E, R any](fn func(A, B, C, D, E) R) func(A) func(B) func(C) func(D) func(E) R {
+	return func(a A) func(B) func(C) func(D) func(E) R {
+		return func(b B) func(C) func(D) func(E) R {
+			return func(c C) func(D) func(E) R {
+				return func(d D) func(E) R {
+					return func(e E) R {
+						return fn(a, b, c, d, e)
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry5 is the inverse of Curry5.
+func Uncurry5[A, B, C, D, E, R any](fn func(A) func(B) func(C) func(D) func(E) R) func(A, B, C, D, E) R {
+	return func(a A, b B, c C, d D, e E) R {
+		return fn(a)(b)(c)(d)(e)
+	}
+}
+
+// Partial5 fix the first argument of 'fn'.
+func Partial5[A, B, C, D, E, R any](fn func(A, B, C, D, E) R, a A) func(B, C, D, E) R {
+	return func(b B, c C, d D, e E) R {
+		return fn(a, b, c, d, e)
+	}
+}
+
+// PartialRight5 fix the last argument of 'fn'.
+func PartialRight5[A, B, C, D, E, R any](fn func(A, B, C, D, E) R, e E) func(A, B, C, D) R {
+	return func(a A, b B, c C, d D) R {
+		return fn(a, b, c, d, e)
+	}
+}
+
+// Flip5 swap the first two arguments of 'fn'.
+func Flip5[A, B, C, D, E, R any](fn func(A, B, C, D, E) R) func(B, A, C, D, E) R {
+	return func(b B, a A, c C, d D, e E) R {
+		return fn(a, b, c, d, e)
+	}
+}
+
+// Bind5 fix all the arguments of 'fn', this function is lazy.
+func Bind5[A, B, C, D, E, R any](fn func(A, B, C, D, E) R, a A, b B, c C, d D, e E) func() R {
+	return func() R {
+		return fn(a, b, c, d, e)
+	}
+}
+
+// Curry6 turn a function of six arguments into a chain of functions of one
+// argument.
+func Curry6[A, B, C, D, E, F, R any](fn func(A, B, C, D, E, F) R) func(A) func(B) func(C) func(D) func(E) func(F) R {
+	return func(a A) func(B) func(C) func(D) func(E) func(F) R {
+		return func(b B) func(C) func(D) func(E) func(F) R {
+			return func(c C) func(D) func(E) func(F) R {
+				return func(d D) func(E) func(F) R {
+					return func(e E) func(F) R {
+						return func(f F) R {
+							return fn(a, b, c, d, e, f)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry6 is the inverse of Curry6.
+func Uncurry6[A, B, C, D, E, F, R any](fn func(A) func(B) func(C) func(D) func(E) func(F) R) func(A, B, C, D, E, F) R {
+	return func(a A, b B, c C, d D, e E, f F) R {
+		return fn(a)(b)(c)(d)(e)(f)
+	}
+}
+
+// Partial6 fix the first argument of 'fn'.
+func Partial6[A, B, C, D, E, F, R any](fn func(A, B, C, D, E, F) R, a A) func(B, C, D, E, F) R {
+	return func(b B, c C, d D, e E, f F) R {
+		return fn(a, b, c, d, e, f)
+	}
+}
+
+// PartialRight6 fix the last argument of 'fn'.
+func PartialRight6[A, B, C, D, E, F, R any](fn func(A, B, C, D, E, F) R, f F) func(A, B, C, D, E) R {
+	return func(a A, b B, c C, d D, e E) R {
+		return fn(a, b, c, d, e, f)
+	}
+}
+
+// Flip6 swap the first two arguments of 'fn'.
+func Flip6[A, B, C, D, E, F, R any](fn func(A, B, C, D, E, F) R) func(B, A, C, D, E, F) R {
+	return func(b B, a A, c C, d D, e E, f F) R {
+		return fn(a, b, c, d, e, f)
+	}
+}
+
+// Bind6 fix all the arguments of 'fn', this function is lazy.
+func Bind6[A, B, C, D, E, F, R any](fn func(A, B, C, D, E, F) R, a A, b B, c C, d D, e E, f F) func() R {
+	return func() R {
+		return fn(a, b, c, d, e, f)
+	}
+}
@@ -0,0 +1,99 @@
+package either
+
+// Package either implements a value of one of two types, by convention Left
+// holds the failure and Right the success, so Map acts on Right.
+import (
+	"fmt"
+
+	"github.com/molikatty/fp"
+)
+
+// Either holds a Left or a Right value, the zero value is Left with the zero
+// value.
+type Either[L, R any] struct {
+	l     L
+	r     R
+	right bool
+}
+
+// Left an either holding 'l'.
+func Left[L, R any](l L) Either[L, R] {
+	return Either[L, R]{l: l}
+}
+
+// Right an either holding 'r'.
+func Right[L, R any](r R) Either[L, R] {
+	return Either[L, R]{r: r, right: true}
+}
+
+// Map the Right value, a Left stays.
+func Map[T, L, R any](e Either[L, R], fn func(R) T) Either[L, T] {
+	return fp.If(e.right, func() Either[L, T] { return Right[L](fn(e.r)) }, func() Either[L, T] {
+		return Left[L, T](e.l)
+	})
+}
+
+// MapLeft map the Left value, a Right stays.
+func MapLeft[T, L, R any](e Either[L, R], fn func(L) T) Either[T, R] {
+	return fp.If(e.right, func() Either[T, R] { return Right[T](e.r) }, func() Either[T, R] {
+		return Left[T, R](fn(e.l))
+	})
+}
+
+// FlatMap the Right value to another either, a Left stays.
+func FlatMap[T, L, R any](e Either[L, R], fn func(R) Either[L, T]) Either[L, T] {
+	return fp.If(e.right, func() Either[L, T] { return fn(e.r) }, func() Either[L, T] {
+		return Left[L, T](e.l)
+	})
+}
+
+// Fold both sides into one value.
+func Fold[T, L, R any](e Either[L, R], left func(L) T, right func(R) T) T {
+	return fp.If(e.right, func() T { return right(e.r) }, func() T { return left(e.l) })
+}
+
+// Partition split an iterator of eithers into the Left and the Right values.
+func Partition[L, R any](next fp.Next[Either[L, R]]) (lefts []L, rights []R) {
+	lefts, rights = make([]L, 0), make([]R, 0)
+	fp.Loop(next, func(e Either[L, R]) {
+		if e.right {
+			rights = append(rights, e.r)
+			return
+		}
+
+		lefts = append(lefts, e.l)
+	})
+
+	return
+}
+
+// IsLeft check the either holds a Left value.
+func (e Either[L, R]) IsLeft() bool {
+	return fp.Not(e.right)
+}
+
+// IsRight check the either holds a Right value.
+func (e Either[L, R]) IsRight() bool {
+	return e.right
+}
+
+// Left get the Left value and whether there is one.
+func (e Either[L, R]) Left() (L, bool) {
+	return e.l, fp.Not(e.right)
+}
+
+// Right get the Right value and whether there is one.
+func (e Either[L, R]) Right() (R, bool) {
+	return e.r, e.right
+}
+
+// Swap the sides.
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Either[R, L]{l: e.r, r: e.l, right: fp.Not(e.right)}
+}
+
+func (e Either[L, R]) String() string {
+	return fp.If(e.right, func() string { return fmt.Sprintf("Right(%v)", e.r) }, func() string {
+		return fmt.Sprintf("Left(%v)", e.l)
+	})
+}
@@ -0,0 +1,42 @@
+package either
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/molikatty/fp"
+	"github.com/molikatty/fp/slice"
+)
+
+func TestExample(t *testing.T) {
+	t.Run("Map", func(t *testing.T) {
+		var e = Map(Right[error](21), func(i int) int { return i * 2 })
+		if r, ok := e.Right(); !ok || r != 42 {
+			t.Error("Not equal", e)
+		}
+
+		var l = Map(Left[string, int]("bad"), strconv.Itoa)
+		if l.IsRight() || Fold(l, func(s string) int { return len(s) }, func(string) int { return 0 }) != 3 {
+			t.Error("Not equal", l)
+		}
+
+		if s, ok := MapLeft(Left[int, string](1), strconv.Itoa).Swap().Right(); !ok || s != "1" {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Partition", func(t *testing.T) {
+		var parse = func(s string) Either[string, int] {
+			n, err := strconv.Atoi(s)
+			return fp.If(err == nil, func() Either[string, int] { return Right[string](n) }, func() Either[string, int] {
+				return Left[string, int](s)
+			})
+		}
+
+		lefts, rights := Partition(fp.Map[Either[string, int]](slice.Iter(slice.Of("1", "a", "2")), parse))
+		if !reflect.DeepEqual(lefts, []string{"a"}) || !reflect.DeepEqual(rights, []int{1, 2}) {
+			t.Error("Not equal", lefts, rights)
+		}
+	})
+}
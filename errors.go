@@ -3,3 +3,21 @@ package fp
 import "errors"
 
 var ErrLeastOne = errors.New("expected at least 1 argument, got 0")
+
+var ErrNotPositive = errors.New("expected a positive number")
+
+var ErrLag = errors.New("consumer lags too far behind the others")
+
+var ErrOverflow = errors.New("arithmetic overflow")
+
+var ErrZeroStep = errors.New("step cannot be zero")
+
+var ErrNotFunc = errors.New("not a function")
+
+var ErrArity = errors.New("wrong number of arguments")
+
+var ErrArgType = errors.New("argument of wrong type")
+
+// Reduced returned by the function of FoldLeftErr to stop the fold early, the
+// accumulator returned along with it is the result.
+var Reduced = errors.New("reduced")
@@ -0,0 +1,53 @@
+package fp
+
+import "errors"
+
+// FoldLeft reduce from left to right starting from 'init', the accumulator
+// can be of any type.
+//
+//	FoldLeft(Iter(1, 2, 3), 0, add) -> ((0+1)+2)+3
+func FoldLeft[E, A any](next Next[E], init A, fn func(A, E) A) A {
+	Loop(next, func(e E) { init = fn(init, e) })
+	return init
+}
+
+// FoldLeftErr like FoldLeft, but 'fn' can stop the fold: returning Reduced
+// stops with its accumulator and a nil error, any other error stops with the
+// error.
+func FoldLeftErr[E, A any](next Next[E], init A, fn func(A, E) (A, error)) (A, error) {
+	var err error
+	ForEach(next, func(e E) bool {
+		init, err = fn(init, e)
+		return err == nil
+	})
+
+	if errors.Is(err, Reduced) {
+		err = nil
+	}
+
+	return init, err
+}
+
+// FoldRight reduce from right to left starting from 'init', the iterator must
+// be finite, its values are kept in memory.
+//
+//	FoldRight(Iter(1, 2, 3), 0, add) -> 1+(2+(3+0))
+func FoldRight[E, A any](next Next[E], init A, fn func(E, A) A) A {
+	var s = Slice(next)
+	for i := len(s) - 1; i >= 0; i-- {
+		init = fn(s[i], init)
+	}
+
+	return init
+}
+
+// ReduceOpt like Reduce, but start from the first value instead of the zero
+// value, false if the iterator is empty.
+func ReduceOpt[E any](next Next[E], fn func(E, E) E) (E, bool) {
+	first, ok := next()
+	if Not(ok) {
+		return Zero[E](), false
+	}
+
+	return FoldLeft(next, first, fn), true
+}
@@ -0,0 +1,52 @@
+package fp
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestFold(t *testing.T) {
+	t.Run("FoldLeft", func(t *testing.T) {
+		var m = FoldLeft(iterate([]string{"a", "b", "a"}), map[string]int{}, func(m map[string]int, s string) map[string]int {
+			m[s]++
+			return m
+		})
+
+		if !reflect.DeepEqual(m, map[string]int{"a": 2, "b": 1}) {
+			t.Error("Not equal", m)
+		}
+
+		var s = FoldRight(Range(1, 4), "", func(i int, a string) string { return a + strconv.Itoa(i) })
+		if s != "321" {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("FoldLeftErr", func(t *testing.T) {
+		var n, err = FoldLeftErr(Iota[int](), 0, func(a, i int) (int, error) {
+			return a + i, If(a+i > 10, Lazy(Reduced), Zero[error])
+		})
+
+		if err != nil || n != 15 {
+			t.Error("Not equal", n, err)
+		}
+
+		var fail = errors.New("fail")
+		if _, err = FoldLeftErr(Range(3), 0, func(a, i int) (int, error) { return a, fail }); !errors.Is(err, fail) {
+			t.Error("Not equal", err)
+		}
+	})
+
+	t.Run("ReduceOpt", func(t *testing.T) {
+		var max = func(a, b int) int { return If(a > b, Lazy(a), Lazy(b)) }
+		if n, ok := ReduceOpt(iterate([]int{-3, -1, -2}), max); !ok || n != -1 {
+			t.Error("Not equal", n)
+		}
+
+		if _, ok := ReduceOpt(Range(0), max); ok {
+			t.Error("empty iterator")
+		}
+	})
+}
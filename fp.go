@@ -2,30 +2,44 @@ package fp
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"sync"
 )
 
-// Curry is currying of any func
-func Curry(fn any) FAnys {
+//go:generate go run ./internal/gen/curry
+
+// Curry is currying of any func, the arguments can be given in one or more
+// calls, the func is called once all of them are given. A variadic func is
+// called once the fixed arguments are given, the extra arguments are passed to
+// the variadic parameter. The returned func gives an error wrapping ErrNotFunc,
+// ErrArity or ErrArgType instead of panicking, use Curry2..Curry6 when the
+// type of the func is known.
+func Curry(fn any) FAnysErr {
 	var (
 		fv    = reflect.ValueOf(fn)
-		n     = fv.Type().NumIn()
-		curry FAnys
+		curry FAnysErr
 	)
 
-	curry = func(args ...any) any {
-		var result = func() any {
-			return apply(fv, args)
+	if fv.Kind() != reflect.Func {
+		return func(...any) (any, error) {
+			return nil, fmt.Errorf("%w: %T", ErrNotFunc, fn)
 		}
+	}
 
-		var again = func() any {
-			return func(margs ...any) any {
-				return curry(append(args, margs...)...)
-			}
+	var n = fv.Type().NumIn() - If(fv.Type().IsVariadic(), Lazy(1), Zero[int])
+	curry = func(args ...any) (any, error) {
+		if _, err := values(fv.Type(), args); err != nil {
+			return nil, err
 		}
 
-		return If(len(args) >= n, result, again)
+		if len(args) < n {
+			return FAnysErr(func(margs ...any) (any, error) {
+				return curry(append(append(make([]any, 0, len(args)+len(margs)), args...), margs...)...)
+			}), nil
+		}
+
+		return apply(fv, args), nil
 	}
 
 	return curry
@@ -98,9 +112,9 @@ func Apply(fn any, args []any) any {
 }
 
 func apply(fv reflect.Value, args []any) any {
-	var argvs = make([]reflect.Value, 0, len(args))
-	for i := range args {
-		argvs = append(argvs, reflect.ValueOf(args[i]))
+	var argvs, err = values(fv.Type(), args)
+	if err != nil {
+		panic(err)
 	}
 
 	var answer = fv.Call(argvs)
@@ -119,6 +133,36 @@ func apply(fv reflect.Value, args []any) any {
 	}
 }
 
+// values check the arguments against the parameters of a func type, at most
+// all of them are given, nil is the zero value of the parameter.
+func values(ft reflect.Type, args []any) ([]reflect.Value, error) {
+	var n = ft.NumIn()
+	if !ft.IsVariadic() && len(args) > n {
+		return nil, fmt.Errorf("%w: expected %d, got %d", ErrArity, n, len(args))
+	}
+
+	var argvs = make([]reflect.Value, len(args))
+	for i := range args {
+		var in = If(ft.IsVariadic() && i >= n-1, func() reflect.Type { return ft.In(n - 1).Elem() }, func() reflect.Type { return ft.In(i) })
+		if args[i] == nil {
+			switch in.Kind() {
+			case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+				argvs[i] = reflect.Zero(in)
+				continue
+			}
+
+			return nil, fmt.Errorf("%w: argument %d is nil, expected %s", ErrArgType, i, in)
+		}
+
+		argvs[i] = reflect.ValueOf(args[i])
+		if !argvs[i].Type().AssignableTo(in) {
+			return nil, fmt.Errorf("%w: argument %d is %s, expected %s", ErrArgType, i, argvs[i].Type(), in)
+		}
+	}
+
+	return argvs, nil
+}
+
 // Ptr used to indirectly obtain a pointer to data that
 // cannot be directly obtained as a pointer. example obtaining pointers to
 // literal data and function return values
@@ -152,52 +196,146 @@ func Elem[T any](t *T) T {
 	return *t
 }
 
-// Min smallest of values.
+// NaNPolicy how Min, Max and the functions alike treat NaN.
+type NaNPolicy int
+
+const (
+	// NaNPropagate the first NaN is the result, like math.Min, the default.
+	NaNPropagate NaNPolicy = iota
+	// NaNSkip NaN is ignored, the result is false if all values are NaN.
+	NaNSkip
+)
+
+// Min smallest of values, panics if there is no value.
+// NaN is the result if any value is NaN.
 func Min[T Size](n ...T) T {
 	if len(n) < 1 {
 		panic(ErrLeastOne)
 	}
 
-	var min T
-	for i := range n {
-		min = If(n[i] < min || IsNaN(n[i]), Lazy(n[i]), Lazy(min))
+	var min = n[0]
+	for _, v := range n[1:] {
+		if less(v, min) {
+			min = v
+		}
 	}
 
 	return min
 }
 
-// MinFrom get smallest of iterated.
-func MinFrom[T Size](n Next[T]) T {
-	var min T
-	for m := range From(n) {
-		min = If(m < min || IsNaN(m), Lazy(m), Lazy(min))
-	}
-
-	return min
+// MinFrom get smallest of iterated, false if the iterator is empty.
+func MinFrom[T Size](n Next[T], policy ...NaNPolicy) (T, bool) {
+	min, ok := extreme(nanPolicy(n, policy), less[T])
+	return min.Value(), ok
 }
 
-// Max get maximum of values
+// Max get maximum of values, panics if there is no value.
+// NaN is the result if any value is NaN.
 func Max[T Size](n ...T) T {
 	if len(n) < 1 {
 		panic(ErrLeastOne)
 	}
 
-	var max T
-	for i := range n {
-		max = If(n[i] > max || IsNaN(n[i]), Lazy(n[i]), Lazy(max))
+	var max = n[0]
+	for _, v := range n[1:] {
+		if greater(v, max) {
+			max = v
+		}
 	}
 
 	return max
 }
 
-// MaxFrom get maximum of iterated
-func MaxFrom[T Size](n Next[T]) T {
-	var max T
-	for m := range From(n) {
-		max = If(m > max || IsNaN(m), Lazy(m), Lazy(max))
+// MaxFrom get maximum of iterated, false if the iterator is empty.
+func MaxFrom[T Size](n Next[T], policy ...NaNPolicy) (T, bool) {
+	max, ok := extreme(nanPolicy(n, policy), greater[T])
+	return max.Value(), ok
+}
+
+// MinMax get smallest and maximum of iterated in one pass, false if the
+// iterator is empty.
+func MinMax[T Size](n Next[T], policy ...NaNPolicy) (min, max T, ok bool) {
+	ForEach(nanPolicy(n, policy), func(p Pairs[int, T]) bool {
+		var v = p.Value()
+		if Not(ok) {
+			min, max, ok = v, v, true
+		}
+
+		min = If(less(v, min), Lazy(v), Lazy(min))
+		max = If(greater(v, max), Lazy(v), Lazy(max))
+		return NotNaN(v)
+	})
+
+	return
+}
+
+// ArgMin get the index of the first smallest value, false if the iterator is
+// empty.
+func ArgMin[T Size](n Next[T], policy ...NaNPolicy) (int, bool) {
+	min, ok := extreme(nanPolicy(n, policy), less[T])
+	return min.Key(), ok
+}
+
+// ArgMax get the index of the first maximum value, false if the iterator is
+// empty.
+func ArgMax[T Size](n Next[T], policy ...NaNPolicy) (int, bool) {
+	max, ok := extreme(nanPolicy(n, policy), greater[T])
+	return max.Key(), ok
+}
+
+// MinBy get the first value with the smallest key, false if the iterator is
+// empty.
+func MinBy[E any, K Size](next Next[E], key func(E) K) (E, bool) {
+	return MinFunc(next, func(a, b E) bool { return less(key(a), key(b)) })
+}
+
+// MaxBy get the first value with the maximum key, false if the iterator is
+// empty.
+func MaxBy[E any, K Size](next Next[E], key func(E) K) (E, bool) {
+	return MinFunc(next, func(a, b E) bool { return greater(key(a), key(b)) })
+}
+
+// MinFunc get the first smallest value in the order of 'less', false if the
+// iterator is empty.
+func MinFunc[E any](next Next[E], less func(E, E) bool) (E, bool) {
+	min, ok := extreme(Enumerate(next), less)
+	return min.Value(), ok
+}
+
+// MaxFunc get the first maximum value in the order of 'less', false if the
+// iterator is empty.
+func MaxFunc[E any](next Next[E], less func(E, E) bool) (E, bool) {
+	return MinFunc(next, func(a, b E) bool { return less(b, a) })
+}
+
+// extreme get the first value no other value is 'better' than, with its index.
+func extreme[E any](next Next[Pairs[int, E]], better func(E, E) bool) (best Pairs[int, E], ok bool) {
+	Loop(next, func(p Pairs[int, E]) {
+		if Not(ok) || better(p.Value(), best.Value()) {
+			best, ok = p, true
+		}
+	})
+
+	return
+}
+
+// less orders NaN before any other value, so that it propagates.
+func less[T Size](a, b T) bool {
+	return a < b || IsNaN(a) && NotNaN(b)
+}
+
+// greater orders NaN before any other value, so that it propagates.
+func greater[T Size](a, b T) bool {
+	return a > b || IsNaN(a) && NotNaN(b)
+}
+
+func nanPolicy[T Size](n Next[T], policy []NaNPolicy) Next[Pairs[int, T]] {
+	var next = Enumerate(n)
+	if len(policy) > 0 && policy[0] == NaNSkip {
+		return Filter(next, func(p Pairs[int, T]) bool { return NotNaN(p.Value()) })
 	}
 
-	return max
+	return next
 }
 
 // Sum of values
@@ -213,9 +351,9 @@ func Sum[N Number](n ...N) N {
 // SumFrom of iterated
 func SumFrom[N Number](n Next[N]) N {
 	var sum N
-	for n := range From(n) {
-		sum += n
-	}
+	Loop(n, func(m N) {
+		sum += m
+	})
 
 	return sum
 }
@@ -1,10 +1,217 @@
 package fp
 
 import (
+	"errors"
+	"math"
 	"strconv"
 	"testing"
 )
 
+func TestExample(t *testing.T) {
+	t.Run("Min", func(t *testing.T) {
+		if Min(3, 5) != 3 || Max(-3, -5) != -3 || Min("b", "a") != "a" {
+			t.Error("Not equal")
+		}
+
+		if n := Min(1, math.NaN(), 0); NotNaN(n) {
+			t.Error("NaN should propagate", n)
+		}
+
+		if n := testing.AllocsPerRun(10, func() { Min(3, 1, 2) }); n != 0 {
+			t.Error("Min allocates", n)
+		}
+
+		if _, ok := MaxFrom(Range(0)); ok {
+			t.Error("empty iterator")
+		}
+	})
+
+	t.Run("NaNSkip", func(t *testing.T) {
+		var values = []float64{2, math.NaN(), -1, 3}
+		if n, ok := MinFrom(iterate(values), NaNSkip); !ok || n != -1 {
+			t.Error("Not equal", n)
+		}
+
+		if min, max, ok := MinMax(iterate(values), NaNSkip); !ok || min != -1 || max != 3 {
+			t.Error("Not equal", min, max)
+		}
+
+		if i, ok := ArgMax(iterate(values), NaNSkip); !ok || i != 3 {
+			t.Error("Not equal", i)
+		}
+
+		if _, ok := MinFrom(iterate([]float64{math.NaN()}), NaNSkip); ok {
+			t.Error("all NaN")
+		}
+	})
+
+	t.Run("ArgMin", func(t *testing.T) {
+		if i, ok := ArgMin(iterate([]int{3, 1, 2, 1})); !ok || i != 1 {
+			t.Error("Not equal", i)
+		}
+
+		if min, max, ok := MinMax(iterate([]int{3, 1, 2, 1})); !ok || min != 1 || max != 3 {
+			t.Error("Not equal", min, max)
+		}
+	})
+
+	t.Run("MinBy", func(t *testing.T) {
+		var words = []string{"ccc", "a", "bb", "d"}
+		var length = func(s string) int { return len(s) }
+		if s, ok := MinBy(iterate(words), length); !ok || s != "a" {
+			t.Error("Not equal", s)
+		}
+
+		if s, ok := MaxBy(iterate(words), length); !ok || s != "ccc" {
+			t.Error("Not equal", s)
+		}
+
+		if s, ok := MaxFunc(iterate(words), func(a, b string) bool { return a < b }); !ok || s != "d" {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Checked", func(t *testing.T) {
+		if !IsFloat[float32]() || IsFloat[uint8]() {
+			t.Error("Not equal")
+		}
+
+		if _, err := SumChecked[int8](100, 100); !errors.Is(err, ErrOverflow) {
+			t.Error("expected overflow", err)
+		}
+
+		if n, err := SumChecked[int8](100, 27); err != nil || n != 127 {
+			t.Error("Not equal", n, err)
+		}
+
+		if _, err := SubChecked[uint](1, 2); !errors.Is(err, ErrOverflow) {
+			t.Error("expected overflow", err)
+		}
+
+		if _, err := MulChecked[int8](-128, -1); !errors.Is(err, ErrOverflow) {
+			t.Error("expected overflow", err)
+		}
+
+		if n, err := MulChecked[int8](-64, 2); err != nil || n != -128 {
+			t.Error("Not equal", n, err)
+		}
+
+		if _, err := MulChecked(math.MaxFloat64, 2); !errors.Is(err, ErrOverflow) {
+			t.Error("expected overflow", err)
+		}
+	})
+
+	t.Run("SumWide", func(t *testing.T) {
+		if s := SumWide[int64](math.MaxInt64, math.MaxInt64, -1); s.String() != "18446744073709551613" {
+			t.Error("Not equal", s)
+		}
+
+		if s := SumKahan(1e16, 1.0, -1e16); s != 1 {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Product", func(t *testing.T) {
+		if p := Product(1, 2, 3, 4); p != 24 || Product[int]() != 1 {
+			t.Error("Not equal", p)
+		}
+
+		if a, ok := Average[int8](100, 100, 100); !ok || a != 100 {
+			t.Error("Not equal", a)
+		}
+	})
+
+	t.Run("CurryN", func(t *testing.T) {
+		var cat = func(s ...string) string { return Reduce(iterate(s), func(a, b string) string { return a + b }) }
+		var f2 = func(a, b string) string { return cat(a, b) }
+		var f3 = func(a, b, c string) string { return cat(a, b, c) }
+		var f4 = func(a, b, c, d string) string { return cat(a, b, c, d) }
+		var f5 = func(a, b, c, d, e string) string { return cat(a, b, c, d, e) }
+		var f6 = func(a, b, c, d, e, f string) string { return cat(a, b, c, d, e, f) }
+
+		var cases = []struct {
+			want string
+			got  []string
+		}{
+			{f2("a", "b"), []string{
+				Curry2(f2)("a")("b"), Uncurry2(Curry2(f2))("a", "b"), Partial2(f2, "a")("b"),
+				PartialRight2(f2, "b")("a"), Flip2(f2)("b", "a"), Bind2(f2, "a", "b")(),
+			}},
+			{f3("a", "b", "c"), []string{
+				Curry3(f3)("a")("b")("c"), Uncurry3(Curry3(f3))("a", "b", "c"), Partial3(f3, "a")("b", "c"),
+				PartialRight3(f3, "c")("a", "b"), Flip3(f3)("b", "a", "c"), Bind3(f3, "a", "b", "c")(),
+			}},
+			{f4("a", "b", "c", "d"), []string{
+				Curry4(f4)("a")("b")("c")("d"), Uncurry4(Curry4(f4))("a", "b", "c", "d"), Partial4(f4, "a")("b", "c", "d"),
+				PartialRight4(f4, "d")("a", "b", "c"), Flip4(f4)("b", "a", "c", "d"), Bind4(f4, "a", "b", "c", "d")(),
+			}},
+			{f5("a", "b", "c", "d", "e"), []string{
+				Curry5(f5)("a")("b")("c")("d")("e"), Uncurry5(Curry5(f5))("a", "b", "c", "d", "e"), Partial5(f5, "a")("b", "c", "d", "e"),
+				PartialRight5(f5, "e")("a", "b", "c", "d"), Flip5(f5)("b", "a", "c", "d", "e"), Bind5(f5, "a", "b", "c", "d", "e")(),
+			}},
+			{f6("a", "b", "c", "d", "e", "f"), []string{
+				Curry6(f6)("a")("b")("c")("d")("e")("f"), Uncurry6(Curry6(f6))("a", "b", "c", "d", "e", "f"), Partial6(f6, "a")("b", "c", "d", "e", "f"),
+				PartialRight6(f6, "f")("a", "b", "c", "d", "e"), Flip6(f6)("b", "a", "c", "d", "e", "f"), Bind6(f6, "a", "b", "c", "d", "e", "f")(),
+			}},
+		}
+
+		for n, c := range cases {
+			for i, got := range c.got {
+				if got != c.want {
+					t.Error("Not equal", n+2, i, got, c.want)
+				}
+			}
+		}
+	})
+
+	t.Run("Curry", func(t *testing.T) {
+		var add = Curry(func(a, b, c int) int { return a + b + c })
+		var partial, err = add(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if v, err := partial.(FAnysErr)(2, 3); err != nil || v != 6 {
+			t.Error("Not equal", v, err)
+		}
+
+		if _, err := add(1, 2, 3, 4); !errors.Is(err, ErrArity) {
+			t.Error("expected ErrArity", err)
+		}
+
+		if _, err := add(1, "2"); !errors.Is(err, ErrArgType) {
+			t.Error("expected ErrArgType", err)
+		}
+
+		if _, err := Curry(1)(); !errors.Is(err, ErrNotFunc) {
+			t.Error("expected ErrNotFunc", err)
+		}
+	})
+
+	t.Run("CurryVariadic", func(t *testing.T) {
+		var join = Curry(func(sep string, s ...string) string {
+			var r string
+			for i := range s {
+				r += If(i > 0, Lazy(sep), Zero[string]) + s[i]
+			}
+
+			return r
+		})
+
+		if v, err := join(",", "a", "b"); err != nil || v != "a,b" {
+			t.Error("Not equal", v, err)
+		}
+
+		if _, err := join(",", "a", 1); !errors.Is(err, ErrArgType) {
+			t.Error("expected ErrArgType", err)
+		}
+
+		if v, err := Curry(func(err error) bool { return err == nil })(nil); err != nil || v != true {
+			t.Error("Not equal", v, err)
+		}
+	})
+}
+
 func BenchmarkExample(b *testing.B) {
 	b.Run("Compose", func(b *testing.B) {
 		var t1 = func(n int) int {
@@ -54,7 +261,8 @@ func BenchmarkExample(b *testing.B) {
 		}
 
 		for i := 0; i < b.N; i++ {
-			_ = Curry(t1)(10, 20).(int)
+			v, _ := Curry(t1)(10, 20)
+			_ = v.(int)
 		}
 	})
 
@@ -0,0 +1,70 @@
+package fp
+
+// GroupBy group the values of the iterator by 'key', the values of a group
+// keep the order of the iterator.
+func GroupBy[K comparable, E any](next Next[E], key func(E) K) map[K][]E {
+	var m = make(map[K][]E)
+	Loop(next, func(e E) {
+		var k = key(e)
+		m[k] = append(m[k], e)
+	})
+
+	return m
+}
+
+// CountBy count the values of the iterator by 'key'.
+func CountBy[K comparable, E any](next Next[E], key func(E) K) map[K]int {
+	var m = make(map[K]int)
+	Loop(next, func(e E) { m[key(e)]++ })
+
+	return m
+}
+
+// Partition split the values of the iterator into those that evaluate to
+// 'true' and those that do not.
+func Partition[E any](next Next[E], pred func(E) bool) (yes, no []E) {
+	yes, no = make([]E, 0), make([]E, 0)
+	Loop(next, func(e E) {
+		if pred(e) {
+			yes = append(yes, e)
+			return
+		}
+
+		no = append(no, e)
+	})
+
+	return
+}
+
+// Grouped group consecutive values with the same key, similar to Python
+// 'itertools.groupby'. A key may appear again later, use GroupBy to merge them.
+// this function is lazy.
+//
+//	Grouped(Iter(1, 1, 2, 1), Id[int]) -> (1, [1 1]) -> (2, [2]) -> (1, [1])
+func Grouped[K comparable, E any](next Next[E], key func(E) K) Next[Pairs[K, []E]] {
+	var head, ok = Zero[E](), false
+	var started bool
+	return func() (Pairs[K, []E], bool) {
+		if Not(started) {
+			head, ok = next()
+			started = true
+		}
+
+		if Not(ok) {
+			return Zero[Pairs[K, []E]](), false
+		}
+
+		var k, run = key(head), []E{head}
+		for head, ok = next(); ok && key(head) == k; head, ok = next() {
+			run = append(run, head)
+		}
+
+		return Pair(k, run), true
+	}
+}
+
+// ChunkBy split the iterator into runs of consecutive values with the same key.
+// this function is lazy.
+func ChunkBy[K comparable, E any](next Next[E], key func(E) K) Next[[]E] {
+	return Map[[]E](Grouped(next, key), Pairs[K, []E].Value)
+}
@@ -0,0 +1,34 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGroup(t *testing.T) {
+	t.Run("GroupBy", func(t *testing.T) {
+		var parity = func(i int) bool { return i%2 == 0 }
+		if m := GroupBy(Range(5), parity); !reflect.DeepEqual(m, map[bool][]int{true: {0, 2, 4}, false: {1, 3}}) {
+			t.Error("Not equal", m)
+		}
+
+		if m := CountBy(Range(5), parity); !reflect.DeepEqual(m, map[bool]int{true: 3, false: 2}) {
+			t.Error("Not equal", m)
+		}
+
+		if yes, no := Partition(Range(5), parity); !reflect.DeepEqual(yes, []int{0, 2, 4}) || !reflect.DeepEqual(no, []int{1, 3}) {
+			t.Error("Not equal", yes, no)
+		}
+	})
+
+	t.Run("Grouped", func(t *testing.T) {
+		var s = Slice(Grouped(iterate([]int{1, 1, 2, 1}), Id[int]))
+		if !reflect.DeepEqual(s, []Pairs[int, []int]{Pair(1, []int{1, 1}), Pair(2, []int{2}), Pair(1, []int{1})}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(ChunkBy(Take(7, Iota[int]()), func(i int) int { return i / 3 })); !reflect.DeepEqual(s, [][]int{{0, 1, 2}, {3, 4, 5}, {6}}) {
+			t.Error("Not equal", s)
+		}
+	})
+}
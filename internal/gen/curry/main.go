@@ -0,0 +1,159 @@
+// Command curry generates curry.go, the typed currying and partial application
+// helpers of package fp for each arity.
+//
+//	go generate github.com/molikatty/fp
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+const minArity, maxArity = 2, 6
+
+var names = []string{"", "one", "two", "three", "four", "five", "six"}
+
+type arity struct {
+	N     int
+	Name  string
+	Types []string
+	Vars  []string
+}
+
+// Params the types as "a A, b B".
+func (a arity) Params(from, to int) string {
+	var s = make([]string, 0, to-from)
+	for i := from; i < to; i++ {
+		s = append(s, a.Vars[i]+" "+a.Types[i])
+	}
+
+	return strings.Join(s, ", ")
+}
+
+// Chain the curried form of the types from 'from', as "func(B) func(C) R".
+func (a arity) Chain(from int) string {
+	var s strings.Builder
+	for _, t := range a.Types[from:] {
+		s.WriteString("func(" + t + ") ")
+	}
+
+	return s.String() + "R"
+}
+
+// List the types from 'from' to 'to', as "B, C".
+func (a arity) List(from, to int) string {
+	return strings.Join(a.Types[from:to], ", ")
+}
+
+// Flipped the params with the first two swapped, as "b B, a A, c C".
+func (a arity) Flipped() string {
+	var p = []string{a.Vars[1] + " " + a.Types[1], a.Vars[0] + " " + a.Types[0]}
+	for i := 2; i < a.N; i++ {
+		p = append(p, a.Vars[i]+" "+a.Types[i])
+	}
+
+	return strings.Join(p, ", ")
+}
+
+// FlippedList the types with the first two swapped, as "B, A, C".
+func (a arity) FlippedList() string {
+	return strings.Join(append([]string{a.Types[1], a.Types[0]}, a.Types[2:]...), ", ")
+}
+
+func (a arity) Sig() string      { return "func(" + strings.Join(a.Types, ", ") + ") R" }
+func (a arity) TypeList() string { return strings.Join(a.Types, ", ") }
+func (a arity) Args() string     { return strings.Join(a.Vars, ", ") }
+func (a arity) Last() int        { return a.N - 1 }
+
+// Curried the nested closures of Curry.
+func (a arity) Curried() string {
+	var s strings.Builder
+	for i := range a.Types {
+		s.WriteString("return func(" + a.Vars[i] + " " + a.Types[i] + ") " + a.Chain(i+1) + " {\n")
+	}
+
+	s.WriteString("return fn(" + a.Args() + ")\n")
+	s.WriteString(strings.Repeat("}\n", a.N))
+	return s.String()
+}
+
+// Applied the calls of Uncurry, as "fn(a)(b)".
+func (a arity) Applied() string {
+	return "fn(" + strings.Join(a.Vars, ")(") + ")"
+}
+
+var tmpl = template.Must(template.New("curry").Parse(`// Code generated by internal/gen/curry; DO NOT EDIT.
+
+package fp
+{{range .}}
+// Curry{{.N}} turn a function of {{.Name}} arguments into a chain of functions of one
+// argument.
+func Curry{{.N}}[{{.TypeList}}, R any](fn {{.Sig}}) {{.Chain 0}} {
+{{.Curried}}}
+
+// Uncurry{{.N}} is the inverse of Curry{{.N}}.
+func Uncurry{{.N}}[{{.TypeList}}, R any](fn {{.Chain 0}}) {{.Sig}} {
+	return func({{.Params 0 .N}}) R {
+		return {{.Applied}}
+	}
+}
+
+// Partial{{.N}} fix the first argument of 'fn'.
+func Partial{{.N}}[{{.TypeList}}, R any](fn {{.Sig}}, a A) func({{.List 1 .N}}) R {
+	return func({{.Params 1 .N}}) R {
+		return fn({{.Args}})
+	}
+}
+
+// PartialRight{{.N}} fix the last argument of 'fn'.
+func PartialRight{{.N}}[{{.TypeList}}, R any](fn {{.Sig}}, {{.Params .Last .N}}) func({{.List 0 .Last}}) R {
+	return func({{.Params 0 .Last}}) R {
+		return fn({{.Args}})
+	}
+}
+
+// Flip{{.N}} swap the first two arguments of 'fn'.
+func Flip{{.N}}[{{.TypeList}}, R any](fn {{.Sig}}) func({{.FlippedList}}) R {
+	return func({{.Flipped}}) R {
+		return fn({{.Args}})
+	}
+}
+
+// Bind{{.N}} fix all the arguments of 'fn', this function is lazy.
+func Bind{{.N}}[{{.TypeList}}, R any](fn {{.Sig}}, {{.Params 0 .N}}) func() R {
+	return func() R {
+		return fn({{.Args}})
+	}
+}
+{{end}}`))
+
+func main() {
+	var arities []arity
+	for n := minArity; n <= maxArity; n++ {
+		var a = arity{N: n, Name: names[n]}
+		for i := 0; i < n; i++ {
+			a.Types = append(a.Types, string(rune('A'+i)))
+			a.Vars = append(a.Vars, string(rune('a'+i)))
+		}
+
+		arities = append(arities, a)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, arities); err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err = os.WriteFile("curry.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 // Next iterator type with some processing functions implemented for it.
@@ -94,10 +95,13 @@ func Zip[E any](nexts ...Next[E]) Next[[]E] {
 // Lock the iterator to make it concurrent-safe.
 // this function is lazy.
 func Lock[E any](next Next[E]) Next[E] {
-	var lock sync.Mutex
+	return lock(new(sync.Mutex), next)
+}
+
+func lock[E any](l sync.Locker, next Next[E]) Next[E] {
 	return func() (E, bool) {
-		lock.Lock()
-		defer lock.Unlock()
+		l.Lock()
+		defer l.Unlock()
 
 		return next()
 	}
@@ -128,16 +132,11 @@ func ForEach[E any](next Next[E], fn func(E) bool) {
 }
 
 // Stop add a stopping condition to the iterator, often used to stop infinite iterators.
+// The iterator also stops when the inner iterator is exhausted, and stays
+// stopped afterwards.
 // this function is lazy.
 func Stop[E any](next Next[E], fn func(E) bool) Next[E] {
-	return func() (E, bool) {
-		t, _ := next()
-		if fn(t) {
-			return Zero[E](), false
-		}
-
-		return t, true
-	}
+	return TakeWhile(next, func(e E) bool { return Not(fn(e)) })
 }
 
 // From make the iterator compatible with the 'range'.
@@ -146,49 +145,70 @@ func Stop[E any](next Next[E], fn func(E) bool) Next[E] {
 //	for v := range From(Iter){
 //	  // do something
 //	}
+//
+// The iterator is drained by a goroutine, which is blocked until the channel
+// is fully received, use FromClose if the loop may be stopped early.
 func From[E any](next Next[E]) <-chan E {
-	var y = make(chan E)
+	return FromClose(next).Key()
+}
+
+// FromClose like From, but also return a Close to release the goroutine when
+// the channel is no longer received. After Close, the channel is closed as soon
+// as the pending call of the iterator returns.
+//
+//	ch, cancel := FromClose(Iter).Expand()
+//	defer cancel()
+func FromClose[E any](next Next[E]) Pairs[<-chan E, Close] {
+	var y, done = make(chan E), make(chan None)
+	var once sync.Once
 	go func() {
+		defer close(y)
 		for n, ok := next(); ok; n, ok = next() {
-			y <- n
+			select {
+			case y <- n:
+			case <-done:
+				return
+			}
 		}
-
-		close(y)
 	}()
 
-	return y
+	return Pair[<-chan E, Close](y, func() {
+		once.Do(func() { close(done) })
+	})
 }
 
-// Yield iteration in Go
-func Yield[E any](next Next[E]) (yield func() E) {
-	var y = From(next)
-	return func() E {
-		return <-y
+// OnClose pair the iterator with a Close that calls 'fn' once, Close is called
+// automatically when the iterator is exhausted, and the iterator is exhausted
+// after Close. Used by iterators that hold resources.
+func OnClose[E any](next Next[E], fn func()) Pairs[Next[E], Close] {
+	var once sync.Once
+	var closed atomic.Bool
+	var cancel = func() {
+		once.Do(func() {
+			closed.Store(true)
+			fn()
+		})
 	}
-}
 
-// Range generate a range of integers, similar to Python built-in 'range' function.
-func Range[N Integer](r ...N) Next[N] {
-	var iter = func(start, stop, step N) Next[N] {
-		return func() (N, bool) {
-			start += step
-			if start >= stop {
-				return Zero[N](), false
-			}
+	return Pair[Next[E], Close](func() (E, bool) {
+		if closed.Load() {
+			return Zero[E](), false
+		}
 
-			return start, true
+		e, ok := next()
+		if Not(ok) {
+			cancel()
 		}
-	}
 
-	switch len(r) {
-	case 0:
-		panic(ErrLeastOne)
-	case 1:
-		return iter(Zero[N]()-1, r[0], 1)
-	case 2:
-		return iter(r[0]-1, r[1], 1)
-	default:
-		return iter(r[0]-r[2], r[1], r[2])
+		return e, ok
+	}, cancel)
+}
+
+// Yield iteration in Go, returns the zero value when the iterator is exhausted.
+func Yield[E any](next Next[E]) (yield func() E) {
+	return func() E {
+		e, _ := next()
+		return e
 	}
 }
 
@@ -269,22 +289,21 @@ func Single(next Next[bool]) (ok bool) {
 // Merge multiple iterators, will iterate in order from left to right
 func Merge[E any](nexts ...Next[E]) Next[E] {
 	var index, length = 0, len(nexts)
-	var next Next[E]
-	next = func() (E, bool) {
-		if index == length {
-			return Zero[E](), false
-		}
-
-		e, ok := nexts[index]()
-		if Not(ok) {
-			index++
-			return next()
+	return func() (E, bool) {
+		for ; index < length; index++ {
+			if e, ok := nexts[index](); ok {
+				return e, true
+			}
 		}
 
-		return e, true
+		return Zero[E](), false
 	}
+}
 
-	return next
+// Chain same as Merge.
+// this function is lazy.
+func Chain[E any](nexts ...Next[E]) Next[E] {
+	return Merge(nexts...)
 }
 
 // Loop like ForEach, but cannot be actively interrupted
@@ -293,3 +312,244 @@ func Loop[E any](next Next[E], fn func(E)) {
 		fn(e)
 	}
 }
+
+// Skip discard the first 'n' values of the iterator.
+// this function is lazy.
+func Skip[E any](n int, next Next[E]) Next[E] {
+	return func() (E, bool) {
+		for ; n > 0; n-- {
+			if _, ok := next(); Not(ok) {
+				return Zero[E](), false
+			}
+		}
+
+		return next()
+	}
+}
+
+// Peek call 'fn' on each value as it passes through the iterator, often used
+// for debugging.
+// this function is lazy.
+func Peek[E any](next Next[E], fn func(E)) Next[E] {
+	return func() (E, bool) {
+		e, ok := next()
+		if ok {
+			fn(e)
+		}
+
+		return e, ok
+	}
+}
+
+// Distinct keep only the first occurrence of each value, the seen values are
+// kept in memory.
+// this function is lazy.
+func Distinct[E comparable](next Next[E]) Next[E] {
+	return DistinctBy(next, Id[E])
+}
+
+// DistinctBy keep only the first value of each key, the seen keys are kept in
+// memory.
+// this function is lazy.
+func DistinctBy[E any, K comparable](next Next[E], key func(E) K) Next[E] {
+	var seen = make(map[K]None)
+	return Filter(next, func(e E) bool {
+		var k = key(e)
+		if InMap(seen, k) {
+			return false
+		}
+
+		seen[k] = None{}
+		return true
+	})
+}
+
+// DistinctConsecutive drop the values equal to the previous one, similar to
+// the 'uniq' command, only the previous value is kept in memory.
+// this function is lazy.
+func DistinctConsecutive[E comparable](next Next[E]) Next[E] {
+	var prev, started = Zero[E](), false
+	return Filter(next, func(e E) bool {
+		if started && e == prev {
+			return false
+		}
+
+		prev, started = e, true
+		return true
+	})
+}
+
+// Count the values of the iterator.
+func Count[E any](next Next[E]) (n int) {
+	Loop(next, func(E) { n++ })
+	return
+}
+
+// First get the first value of the iterator, false if it is empty.
+func First[E any](next Next[E]) (E, bool) {
+	return next()
+}
+
+func iterate[E any](s []E) Next[E] {
+	var index = -1
+	return func() (E, bool) {
+		index++
+		if index >= len(s) {
+			return Zero[E](), false
+		}
+
+		return s[index], true
+	}
+}
+
+// Scan like Fold, but start from 'init' and the accumulator can be of another
+// type, yields every intermediate accumulator.
+// this function is lazy.
+//
+//	Scan(Iter(1, 2, 3), 10, add) -> 11 -> 13 -> 16
+func Scan[A, E any](next Next[E], init A, fn func(A, E) A) Next[A] {
+	return Map[A](next, func(e E) A {
+		init = fn(init, e)
+		return init
+	})
+}
+
+// FlatMap map each value to an iterator and iterate them one after another.
+// this function is lazy.
+func FlatMap[R, E any](next Next[E], fn func(E) Next[R]) Next[R] {
+	return Flatten(Map[Next[R]](next, fn))
+}
+
+// Flatten iterate the inner iterators one after another.
+// this function is lazy.
+func Flatten[E any](nexts Next[Next[E]]) Next[E] {
+	var inner Next[E]
+	return func() (E, bool) {
+		for {
+			if inner != nil {
+				if e, ok := inner(); ok {
+					return e, true
+				}
+			}
+
+			var ok bool
+			if inner, ok = nexts(); Not(ok) {
+				return Zero[E](), false
+			}
+		}
+	}
+}
+
+// TakeWhile yield values while they evaluate to 'true', the first value that
+// does not is dropped and the iterator stays exhausted afterwards.
+// this function is lazy.
+func TakeWhile[E any](next Next[E], fn func(E) bool) Next[E] {
+	var done bool
+	return func() (E, bool) {
+		if done {
+			return Zero[E](), false
+		}
+
+		e, ok := next()
+		if Not(ok) || Not(fn(e)) {
+			done = true
+			return Zero[E](), false
+		}
+
+		return e, true
+	}
+}
+
+// DropWhile drop values while they evaluate to 'true', then yield the rest.
+// this function is lazy.
+func DropWhile[E any](next Next[E], fn func(E) bool) Next[E] {
+	var dropping = true
+	return Filter(next, func(e E) bool {
+		dropping = dropping && fn(e)
+		return Not(dropping)
+	})
+}
+
+// StepBy yield the first value and then every 'step'-th value. Panics if
+// 'step' is not positive.
+// this function is lazy.
+//
+//	StepBy(Range(10), 3) -> 0 -> 3 -> 6 -> 9
+func StepBy[E any](next Next[E], step int) Next[E] {
+	if step < 1 {
+		panic(ErrNotPositive)
+	}
+
+	var first = true
+	return func() (E, bool) {
+		if first {
+			first = false
+			return next()
+		}
+
+		return Skip(step-1, next)()
+	}
+}
+
+// Cycle repeat the iterator endlessly, the values of the first pass are kept
+// in memory. An empty iterator stays empty.
+// this function is lazy.
+func Cycle[E any](next Next[E]) Next[E] {
+	var seen = make([]E, 0)
+	var index = -1
+	return func() (E, bool) {
+		if index < 0 {
+			if e, ok := next(); ok {
+				seen = append(seen, e)
+				return e, true
+			}
+		}
+
+		if len(seen) == 0 {
+			return Zero[E](), false
+		}
+
+		index = (index + 1) % len(seen)
+		return seen[index], true
+	}
+}
+
+// Repeat yield 'v' 'n' times, endlessly if 'n' is negative.
+// this function is lazy.
+func Repeat[E any](v E, n int) Next[E] {
+	return func() (E, bool) {
+		if n == 0 {
+			return Zero[E](), false
+		}
+
+		n -= If(n > 0, Lazy(1), Zero[int])
+		return v, true
+	}
+}
+
+// Enumerate pair each value with its index, starting from 0.
+// this function is lazy.
+func Enumerate[E any](next Next[E]) Next[Pairs[int, E]] {
+	var index = -1
+	return Map[Pairs[int, E]](next, func(e E) Pairs[int, E] {
+		index++
+		return Pair(index, e)
+	})
+}
+
+// Last get the last value of the iterator, false if it is empty. The iterator
+// must be finite.
+func Last[E any](next Next[E]) (last E, ok bool) {
+	Loop(next, func(e E) { last, ok = e, true })
+	return
+}
+
+// Nth get the value at index 'n' of the iterator, starting from 0, false if
+// the iterator is too short.
+func Nth[E any](next Next[E], n int) (E, bool) {
+	if n < 0 {
+		return Zero[E](), false
+	}
+
+	return Skip(n, next)()
+}
@@ -0,0 +1,166 @@
+package fp
+
+import (
+	"reflect"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// noLeak fails the test if goroutines started by 'fn' are still alive.
+func noLeak(t *testing.T, fn func()) {
+	t.Helper()
+	var before = runtime.NumGoroutine()
+	fn()
+
+	for i := 0; i < 100; i++ {
+		if runtime.NumGoroutine() <= before {
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Error("goroutine leak", runtime.NumGoroutine()-before)
+}
+
+func TestIter(t *testing.T) {
+	t.Run("Yield", func(t *testing.T) {
+		noLeak(t, func() {
+			var y = Yield(Range(1, 4))
+			if s := []int{y(), y(), y(), y()}; !reflect.DeepEqual(s, []int{1, 2, 3, 0}) {
+				t.Error("Not equal", s)
+			}
+		})
+	})
+
+	t.Run("FromClose", func(t *testing.T) {
+		noLeak(t, func() {
+			ch, cancel := FromClose(Iota[int]()).Expand()
+			for v := range ch {
+				if v == 3 {
+					break
+				}
+			}
+
+			cancel()
+			cancel()
+		})
+	})
+
+	t.Run("OnClose", func(t *testing.T) {
+		var closed int
+		next, cancel := OnClose(Range(10), func() { closed++ }).Expand()
+		if s := Slice(Take(2, next)); !reflect.DeepEqual(s, []int{0, 1}) {
+			t.Error("Not equal", s)
+		}
+
+		cancel()
+		if _, ok := next(); ok || closed != 1 {
+			t.Error("not closed", closed)
+		}
+
+		next, _ = OnClose(Range(3), func() { closed++ }).Expand()
+		Loop(next, func(int) {})
+		if closed != 2 {
+			t.Error("not closed on exhaustion", closed)
+		}
+	})
+
+	t.Run("SumFrom", func(t *testing.T) {
+		noLeak(t, func() {
+			if n := SumFrom(Range(5)); n != 10 {
+				t.Error("Not equal", n)
+			}
+		})
+	})
+
+}
+
+func TestDistinct(t *testing.T) {
+	t.Run("Distinct", func(t *testing.T) {
+		var values = []int{1, 1, 2, 3, 1, 3, 3}
+		if s := Slice(Distinct(iterate(values))); !reflect.DeepEqual(s, []int{1, 2, 3}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(DistinctConsecutive(iterate(values))); !reflect.DeepEqual(s, []int{1, 2, 3, 1, 3}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(DistinctBy(Range(10), func(i int) int { return i % 3 })); !reflect.DeepEqual(s, []int{0, 1, 2}) {
+			t.Error("Not equal", s)
+		}
+	})
+}
+
+func TestCombinators(t *testing.T) {
+	t.Run("Scan", func(t *testing.T) {
+		var s = Slice(Scan(Range(1, 4), "", func(a string, i int) string { return a + strconv.Itoa(i) }))
+		if !reflect.DeepEqual(s, []string{"1", "12", "123"}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("FlatMap", func(t *testing.T) {
+		var s = Slice(FlatMap(Range(4), func(i int) Next[int] { return Repeat(i, i) }))
+		if !reflect.DeepEqual(s, []int{1, 2, 2, 3, 3, 3}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("TakeWhile", func(t *testing.T) {
+		var pulled int
+		var next = TakeWhile(Peek(Iota[int](), func(int) { pulled++ }), func(i int) bool { return i < 3 })
+		if s := Slice(next); !reflect.DeepEqual(s, []int{0, 1, 2}) {
+			t.Error("Not equal", s)
+		}
+
+		if _, ok := next(); ok || pulled != 4 {
+			t.Error("not exhausted", pulled)
+		}
+
+		if s := Slice(Stop(Range(3), func(i int) bool { return i > 5 })); !reflect.DeepEqual(s, []int{0, 1, 2}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("DropWhile", func(t *testing.T) {
+		var s = Slice(DropWhile(iterate([]int{1, 2, 5, 1, 6}), func(i int) bool { return i < 3 }))
+		if !reflect.DeepEqual(s, []int{5, 1, 6}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("StepBy", func(t *testing.T) {
+		if s := Slice(StepBy(Range(10), 3)); !reflect.DeepEqual(s, []int{0, 3, 6, 9}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(Skip(8, Range(10))); !reflect.DeepEqual(s, []int{8, 9}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		if s := Slice(Take(7, Cycle(Range(3)))); !reflect.DeepEqual(s, []int{0, 1, 2, 0, 1, 2, 0}) {
+			t.Error("Not equal", s)
+		}
+
+		if _, ok := Cycle(Range(0))(); ok {
+			t.Error("empty cycle")
+		}
+
+		if s := Slice(Take(3, Repeat("a", -1))); !reflect.DeepEqual(s, []string{"a", "a", "a"}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Enumerate", func(t *testing.T) {
+		var s = Slice(Enumerate(Chain(iterate([]string{"a"}), iterate([]string{}), iterate([]string{"b"}))))
+		if !reflect.DeepEqual(s, []Pairs[int, string]{Pair(0, "a"), Pair(1, "b")}) {
+			t.Error("Not equal", s)
+		}
+	})
+}
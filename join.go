@@ -0,0 +1,181 @@
+package fp
+
+// indexBy the values of the iterator by key, keeping their order.
+func indexBy[E any, K comparable](next Next[E], key func(E) K) (map[K][]int, []E) {
+	var m, s = make(map[K][]int), Slice(next)
+	for i := range s {
+		var k = key(s[i])
+		m[k] = append(m[k], i)
+	}
+
+	return m, s
+}
+
+// join the core of the hash joins, 'right' is read into memory on the first
+// call, 'left' is streamed. 'matched' is called for every matching pair,
+// 'unmatched' for every left value without a match, and 'rest' once 'left' is
+// exhausted with the right values that were never matched.
+func join[L, R any, K comparable, E any](
+	left Next[L], right Next[R], lkey func(L) K, rkey func(R) K,
+	matched func(L, R) (E, bool), unmatched func(L) (E, bool), rest func(R) (E, bool),
+) Next[E] {
+	var (
+		m     map[K][]int
+		rs    []R
+		seen  []bool
+		queue []E
+		tail  Next[R]
+	)
+
+	var push = func(e E, ok bool) {
+		if ok {
+			queue = append(queue, e)
+		}
+	}
+
+	return func() (E, bool) {
+		if rs == nil {
+			m, rs = indexBy(right, rkey)
+			seen = make([]bool, len(rs))
+		}
+
+		for len(queue) == 0 {
+			if tail != nil {
+				r, ok := tail()
+				if Not(ok) {
+					return Zero[E](), false
+				}
+
+				push(rest(r))
+				continue
+			}
+
+			l, ok := left()
+			if Not(ok) {
+				var i = -1
+				tail = func() (R, bool) {
+					for i++; i < len(rs); i++ {
+						if Not(seen[i]) {
+							return rs[i], true
+						}
+					}
+
+					return Zero[R](), false
+				}
+				continue
+			}
+
+			var hits = m[lkey(l)]
+			if len(hits) == 0 {
+				push(unmatched(l))
+			}
+
+			for _, i := range hits {
+				seen[i] = true
+				push(matched(l, rs[i]))
+			}
+		}
+
+		return shift(&queue), true
+	}
+}
+
+func none[T, E any](T) (E, bool) {
+	return Zero[E](), false
+}
+
+// HashJoin inner join two iterators on equal keys, every left value is paired
+// with every matching right value, in the order of 'left' then 'right'.
+// 'right' is read into memory on the first call.
+// this function is lazy.
+func HashJoin[L, R any, K comparable](left Next[L], right Next[R], lkey func(L) K, rkey func(R) K) Next[Pairs[L, R]] {
+	return join(left, right, lkey, rkey,
+		func(l L, r R) (Pairs[L, R], bool) { return Pair(l, r), true },
+		none[L, Pairs[L, R]],
+		none[R, Pairs[L, R]],
+	)
+}
+
+// LeftJoin like HashJoin, but also keep the left values without a match,
+// paired with a nil right value.
+// this function is lazy.
+func LeftJoin[L, R any, K comparable](left Next[L], right Next[R], lkey func(L) K, rkey func(R) K) Next[Pairs[L, *R]] {
+	return join(left, right, lkey, rkey,
+		func(l L, r R) (Pairs[L, *R], bool) { return Pair(l, &r), true },
+		func(l L) (Pairs[L, *R], bool) { return Pair[L, *R](l, nil), true },
+		none[R, Pairs[L, *R]],
+	)
+}
+
+// FullOuterJoin like LeftJoin, but also keep the right values without a match,
+// paired with a nil left value after all the left values.
+// this function is lazy.
+func FullOuterJoin[L, R any, K comparable](left Next[L], right Next[R], lkey func(L) K, rkey func(R) K) Next[Pairs[*L, *R]] {
+	return join(left, right, lkey, rkey,
+		func(l L, r R) (Pairs[*L, *R], bool) { return Pair(&l, &r), true },
+		func(l L) (Pairs[*L, *R], bool) { return Pair[*L, *R](&l, nil), true },
+		func(r R) (Pairs[*L, *R], bool) { return Pair[*L](nil, &r), true },
+	)
+}
+
+// SemiJoin keep the left values that have a match in 'right', each once.
+// Only the keys of 'right' are kept in memory.
+// this function is lazy.
+func SemiJoin[L, R any, K comparable](left Next[L], right Next[R], lkey func(L) K, rkey func(R) K) Next[L] {
+	return filterKeys(left, right, lkey, rkey, true)
+}
+
+// AntiJoin keep the left values that have no match in 'right'.
+// Only the keys of 'right' are kept in memory.
+// this function is lazy.
+func AntiJoin[L, R any, K comparable](left Next[L], right Next[R], lkey func(L) K, rkey func(R) K) Next[L] {
+	return filterKeys(left, right, lkey, rkey, false)
+}
+
+func filterKeys[L, R any, K comparable](left Next[L], right Next[R], lkey func(L) K, rkey func(R) K, keep bool) Next[L] {
+	var keys map[K]None
+	return Filter(left, func(l L) bool {
+		if keys == nil {
+			keys = make(map[K]None)
+			Loop(right, func(r R) { keys[rkey(r)] = None{} })
+		}
+
+		return InMap(keys, lkey(l)) == keep
+	})
+}
+
+// MergeJoin inner join two iterators that are both sorted by key in the order
+// of 'less', only the runs of one key are kept in memory.
+// this function is lazy.
+func MergeJoin[L, R any, K comparable](left Next[L], right Next[R], lkey func(L) K, rkey func(R) K, less func(K, K) bool) Next[Pairs[L, R]] {
+	var (
+		lg, rg = Grouped(left, lkey), Grouped(right, rkey)
+		queue  []Pairs[L, R]
+	)
+
+	return func() (Pairs[L, R], bool) {
+		for len(queue) == 0 {
+			l, okl := lg()
+			r, okr := rg()
+			for okl && okr && l.Key() != r.Key() {
+				if less(l.Key(), r.Key()) {
+					l, okl = lg()
+				} else {
+					r, okr = rg()
+				}
+			}
+
+			if Not(okl && okr) {
+				return Zero[Pairs[L, R]](), false
+			}
+
+			for _, lv := range l.Value() {
+				for _, rv := range r.Value() {
+					queue = append(queue, Pair(lv, rv))
+				}
+			}
+		}
+
+		return shift(&queue), true
+	}
+}
@@ -0,0 +1,72 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestJoin(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+
+	type order struct {
+		user int
+		item string
+	}
+
+	var users = []user{{1, "ann"}, {2, "bob"}, {3, "cat"}}
+	var orders = []order{{1, "pen"}, {3, "cup"}, {1, "ink"}, {4, "box"}}
+	var uid = func(u user) int { return u.id }
+	var oid = func(o order) int { return o.user }
+
+	t.Run("HashJoin", func(t *testing.T) {
+		var s = Slice(Map[string](HashJoin(iterate(users), iterate(orders), uid, oid), func(p Pairs[user, order]) string {
+			return p.Key().name + ":" + p.Value().item
+		}))
+
+		if !reflect.DeepEqual(s, []string{"ann:pen", "ann:ink", "cat:cup"}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("LeftJoin", func(t *testing.T) {
+		var s = Slice(Map[string](LeftJoin(iterate(users), iterate(orders), uid, oid), func(p Pairs[user, *order]) string {
+			return p.Key().name + ":" + If(p.Value() == nil, Lazy("-"), func() string { return p.Value().item })
+		}))
+
+		if !reflect.DeepEqual(s, []string{"ann:pen", "ann:ink", "bob:-", "cat:cup"}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("FullOuterJoin", func(t *testing.T) {
+		var s = Slice(FullOuterJoin(iterate(users), iterate(orders), uid, oid))
+		if len(s) != 5 || s[2].Value() != nil || s[4].Key() != nil || s[4].Value().item != "box" {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("SemiJoin", func(t *testing.T) {
+		if s := Slice(SemiJoin(iterate(users), iterate(orders), uid, oid)); !reflect.DeepEqual(s, []user{{1, "ann"}, {3, "cat"}}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(AntiJoin(iterate(users), iterate(orders), uid, oid)); !reflect.DeepEqual(s, []user{{2, "bob"}}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("MergeJoin", func(t *testing.T) {
+		var less = func(a, b int) bool { return a < b }
+		var sorted = []order{{1, "pen"}, {1, "ink"}, {3, "cup"}, {4, "box"}}
+		var s = Slice(Map[string](MergeJoin(iterate(users), iterate(sorted), uid, oid, less), func(p Pairs[user, order]) string {
+			return p.Key().name + ":" + p.Value().item
+		}))
+
+		if !reflect.DeepEqual(s, []string{"ann:pen", "ann:ink", "cat:cup"}) {
+			t.Error("Not equal", s)
+		}
+	})
+}
@@ -1,6 +1,10 @@
 package maps
 
-import "github.com/molikatty/fp"
+import (
+	"github.com/molikatty/fp"
+	"github.com/molikatty/fp/option"
+	"github.com/molikatty/fp/slice"
+)
 
 // Of quickly create a map.
 func Of[K comparable, V any](kvs ...fp.Pairs[K, V]) map[K]V {
@@ -20,23 +24,21 @@ func From[M ~map[K]V, K comparable, V any](next fp.Next[fp.Pairs[K, V]]) M {
 	return fp.KV(next)
 }
 
-// Iter make an iterator for map
+// Iter make an iterator for map, the iterator walks a snapshot of the map
+// taken when it is called, so no goroutine is left behind if it is abandoned.
 func Iter[M ~map[K]V, K comparable, V any](m M) fp.Next[fp.Pairs[K, V]] {
-	var yied = make(chan fp.Pairs[K, V])
-	go func() {
-		for k, v := range m {
-			yied <- fp.Pair(k, v)
-		}
-		close(yied)
-	}()
+	var kvs = make([]fp.Pairs[K, V], 0, len(m))
+	for k, v := range m {
+		kvs = append(kvs, fp.Pair(k, v))
+	}
 
-	return func() (fp.Pairs[K, V], bool) {
-		for v := range yied {
-			return v, true
-		}
+	return slice.Iter(kvs)
+}
 
-		return fp.Zero[fp.Pairs[K, V]](), false
-	}
+// Get the value of a key, None if the key is absent, to tell it from a zero value.
+func Get[M ~map[K]V, K comparable, V any](m M, k K) option.Option[V] {
+	v, ok := m[k]
+	return option.Of(v, ok)
 }
 
 // IsEmpty check map is empty
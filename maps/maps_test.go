@@ -2,6 +2,7 @@ package maps
 
 import (
 	"reflect"
+	"runtime"
 	"testing"
 
 	"github.com/molikatty/fp"
@@ -47,6 +48,21 @@ func TestExample(t *testing.T) {
 		}
 	})
 
+	t.Run("IterTake", func(t *testing.T) {
+		var before = runtime.NumGoroutine()
+		var m = Iter(Of(fp.Pair(1, 1), fp.Pair(2, 2), fp.Pair(3, 3)))
+		if len(fp.Slice(fp.Take(1, m))) != 1 || runtime.NumGoroutine() > before {
+			t.Error("goroutine leak")
+		}
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		var m = Of(fp.Pair("a", 0))
+		if !Get(m, "a").IsSome() || Get(m, "b").IsSome() {
+			t.Error("Not equal")
+		}
+	})
+
 	t.Run("IsEmpty", func(t *testing.T) {
 		t.Log(IsEmpty(Of[int, int]()))
 	})
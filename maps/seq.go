@@ -0,0 +1,16 @@
+//go:build go1.23
+
+package maps
+
+import "iter"
+
+// Seq make an iter.Seq2 for map, no goroutine is started.
+func Seq[M ~map[K]V, K comparable, V any](m M) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package fp
+
+import (
+	"container/heap"
+	"sync"
+)
+
+// MergeSorted merge iterators that are each sorted by 'less' into one sorted
+// iterator, using a heap of the heads of the iterators. Values that are equal
+// are taken from the leftmost iterator first.
+// this function is lazy.
+func MergeSorted[E any](less func(E, E) bool, nexts ...Next[E]) Next[E] {
+	var h *heads[E]
+	return func() (E, bool) {
+		if h == nil {
+			h = &heads[E]{less: less}
+			for i := range nexts {
+				if e, ok := nexts[i](); ok {
+					h.items = append(h.items, head[E]{e, i})
+				}
+			}
+
+			heap.Init(h)
+		}
+
+		if h.Len() == 0 {
+			return Zero[E](), false
+		}
+
+		var top = h.items[0]
+		if e, ok := nexts[top.index](); ok {
+			h.items[0].value = e
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+
+		return top.value, true
+	}
+}
+
+// Interleave take one value from each iterator in turn, an exhausted iterator
+// is skipped.
+// this function is lazy.
+//
+//	Interleave(Iter(1, 2, 3), Iter(4)) -> 1 -> 4 -> 2 -> 3
+func Interleave[E any](nexts ...Next[E]) Next[E] {
+	var active = append(make([]Next[E], 0, len(nexts)), nexts...)
+	var index int
+	return func() (E, bool) {
+		for len(active) > 0 {
+			index %= len(active)
+			if e, ok := active[index](); ok {
+				index++
+				return e, true
+			}
+
+			active = append(active[:index], active[index+1:]...)
+		}
+
+		return Zero[E](), false
+	}
+}
+
+// Concurrently read each iterator on its own goroutine and yield the values in
+// the order they arrive. Close releases the goroutines if the iterator is not
+// exhausted, see FromClose.
+func Concurrently[E any](nexts ...Next[E]) Pairs[Next[E], Close] {
+	var y, done = make(chan E), make(chan None)
+	var once sync.Once
+	run, wait := Async().Expand()
+	for i := range nexts {
+		var next = nexts[i]
+		run(func() {
+			for e, ok := next(); ok; e, ok = next() {
+				select {
+				case y <- e:
+				case <-done:
+					return
+				}
+			}
+		})
+	}
+
+	go func() {
+		wait()
+		close(y)
+	}()
+
+	return OnClose(func() (E, bool) {
+		e, ok := <-y
+		return e, ok
+	}, func() { once.Do(func() { close(done) }) })
+}
+
+type head[E any] struct {
+	value E
+	index int
+}
+
+// heads heap of the current values of the iterators, implements heap.Interface.
+type heads[E any] struct {
+	items []head[E]
+	less  func(E, E) bool
+}
+
+func (h *heads[E]) Len() int {
+	return len(h.items)
+}
+
+func (h *heads[E]) Less(i, j int) bool {
+	var a, b = h.items[i], h.items[j]
+	return h.less(a.value, b.value) || Not(h.less(b.value, a.value)) && a.index < b.index
+}
+
+func (h *heads[E]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *heads[E]) Push(x any) {
+	h.items = append(h.items, AnyTo[head[E]](x))
+}
+
+func (h *heads[E]) Pop() any {
+	var last = h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
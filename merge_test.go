@@ -0,0 +1,35 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	t.Run("MergeSorted", func(t *testing.T) {
+		var less = func(a, b int) bool { return a < b }
+		var s = Slice(MergeSorted(less, iterate([]int{1, 4, 7}), iterate([]int{}), iterate([]int{2, 3, 8, 9}), Range(5, 7)))
+		if !reflect.DeepEqual(s, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Interleave", func(t *testing.T) {
+		if s := Slice(Interleave(Range(1, 4), Range(4, 5), Range(0))); !reflect.DeepEqual(s, []int{1, 4, 2, 3}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Concurrently", func(t *testing.T) {
+		noLeak(t, func() {
+			next, _ := Concurrently(Range(100), Range(100, 200)).Expand()
+			if n := SumFrom(next); n != 19900 {
+				t.Error("Not equal", n)
+			}
+
+			next, cancel := Concurrently(Iota[int](), Iota[int]()).Expand()
+			Slice(Take(10, next))
+			cancel()
+		})
+	})
+}
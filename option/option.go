@@ -0,0 +1,143 @@
+package option
+
+// Package option implements an optional value, to tell an absent value from
+// the zero value.
+//
+//	port := maps.Get(conf, "port").OrElse(8080)
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/molikatty/fp"
+)
+
+// Option either holds a value (Some) or not (None), the zero value is None.
+type Option[T any] struct {
+	v  T
+	ok bool
+}
+
+// Some an option holding 't'.
+func Some[T any](t T) Option[T] {
+	return Option[T]{t, true}
+}
+
+// None an empty option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// Of an option holding 't' if ok, like the result of a map lookup.
+//
+//	Of(m[k]) // v, ok := m[k]
+func Of[T any](t T, ok bool) Option[T] {
+	return fp.If(ok, fp.Lazy(Some(t)), None[T])
+}
+
+// FromPtr an option holding *p, None if 'p' is nil.
+func FromPtr[T any](p *T) Option[T] {
+	return fp.If(p == nil, None[T], func() Option[T] { return Some(*p) })
+}
+
+// Map the value of the option, None stays None.
+func Map[R, T any](o Option[T], fn func(T) R) Option[R] {
+	return fp.If(o.ok, func() Option[R] { return Some(fn(o.v)) }, None[R])
+}
+
+// FlatMap the value of the option to another option, None stays None.
+func FlatMap[R, T any](o Option[T], fn func(T) Option[R]) Option[R] {
+	return fp.If(o.ok, func() Option[R] { return fn(o.v) }, None[R])
+}
+
+// First value of the iterator, None if it is empty.
+func First[E any](next fp.Next[E]) Option[E] {
+	return Of(fp.First(next))
+}
+
+// Last value of the iterator, None if it is empty.
+func Last[E any](next fp.Next[E]) Option[E] {
+	return Of(fp.Last(next))
+}
+
+// Nth value of the iterator, starting from 0, None if it is too short.
+func Nth[E any](next fp.Next[E], n int) Option[E] {
+	return Of(fp.Nth(next, n))
+}
+
+// IsSome check the option holds a value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone check the option is empty.
+func (o Option[T]) IsNone() bool {
+	return fp.Not(o.ok)
+}
+
+// Get the value and whether there is one.
+func (o Option[T]) Get() (T, bool) {
+	return o.v, o.ok
+}
+
+// Unwrap get the value, panics if the option is None.
+func (o Option[T]) Unwrap() T {
+	if fp.Not(o.ok) {
+		panic("option: unwrap of None")
+	}
+
+	return o.v
+}
+
+// OrElse get the value, or 't' if the option is None.
+func (o Option[T]) OrElse(t T) T {
+	return fp.If(o.ok, fp.Lazy(o.v), fp.Lazy(t))
+}
+
+// OrElseGet get the value, or the result of 'fn' if the option is None.
+func (o Option[T]) OrElseGet(fn func() T) T {
+	return fp.If(o.ok, fp.Lazy(o.v), fn)
+}
+
+// Or the option itself if it is Some, otherwise 'other'.
+func (o Option[T]) Or(other Option[T]) Option[T] {
+	return fp.If(o.ok, fp.Lazy(o), fp.Lazy(other))
+}
+
+// Filter keep the value only if it evaluates to 'true'.
+func (o Option[T]) Filter(fn func(T) bool) Option[T] {
+	return fp.If(o.ok && fn(o.v), fp.Lazy(o), None[T])
+}
+
+// Ptr a pointer to a copy of the value, nil if the option is None.
+func (o Option[T]) Ptr() *T {
+	return fp.If(o.ok, func() *T { return fp.Ptr(o.v) }, fp.Zero[*T])
+}
+
+func (o Option[T]) String() string {
+	return fp.If(o.ok, func() string { return fmt.Sprintf("Some(%v)", o.v) }, fp.Lazy("None"))
+}
+
+// MarshalJSON encode None as null and Some as its value.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if fp.Not(o.ok) {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o.v)
+}
+
+// UnmarshalJSON decode null as None and any other value as Some.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &o.v); err != nil {
+		return err
+	}
+
+	o.ok = true
+	return nil
+}
@@ -0,0 +1,61 @@
+package option
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/molikatty/fp"
+)
+
+func TestExample(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		var o = Some(0)
+		if v, ok := o.Get(); !ok || v != 0 || o.OrElse(5) != 0 || None[int]().OrElse(5) != 5 {
+			t.Error("Not equal", o)
+		}
+	})
+
+	t.Run("Map", func(t *testing.T) {
+		var o = Map(Some(42), strconv.Itoa)
+		if o.OrElse("") != "42" || Map(None[int](), strconv.Itoa).IsSome() {
+			t.Error("Not equal", o)
+		}
+
+		var atoi = func(s string) Option[int] {
+			n, err := strconv.Atoi(s)
+			return Of(n, err == nil)
+		}
+
+		if FlatMap(Some("x"), atoi).IsSome() || FlatMap(Some("7"), atoi).Unwrap() != 7 {
+			t.Error("Not equal")
+		}
+
+		if Some(3).Filter(func(i int) bool { return i > 5 }).IsSome() {
+			t.Error("Not equal")
+		}
+	})
+
+	t.Run("Iter", func(t *testing.T) {
+		if First(fp.Range(0)).IsSome() || Last(fp.Range(5)).Unwrap() != 4 || Nth(fp.Range(5), 2).Unwrap() != 2 || Nth(fp.Range(5), 5).IsSome() {
+			t.Error("Not equal")
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type config struct {
+			Port Option[int]    `json:"port"`
+			Host Option[string] `json:"host"`
+		}
+
+		var b, _ = json.Marshal(config{Port: Some(0)})
+		if string(b) != `{"port":0,"host":null}` {
+			t.Error("Not equal", string(b))
+		}
+
+		var c config
+		if err := json.Unmarshal([]byte(`{"port":null,"host":"localhost"}`), &c); err != nil || c.Port.IsSome() || c.Host.Unwrap() != "localhost" {
+			t.Error("Not equal", c, err)
+		}
+	})
+}
@@ -41,3 +41,38 @@ func (p Pairs[K, V]) Expand() (K, V) {
 func (p Pairs[K, V]) String() string {
 	return fmt.Sprintf("(%#v, %#v)", p.f, p.s)
 }
+
+// Triples type is a pair with a third value
+type Triples[A, B, C any] struct {
+	f A
+	s B
+	t C
+}
+
+func Triple[A, B, C any](a A, b B, c C) Triples[A, B, C] {
+	return Triples[A, B, C]{a, b, c}
+}
+
+// First return first value
+func (t Triples[A, B, C]) First() A {
+	return t.f
+}
+
+// Second return second value
+func (t Triples[A, B, C]) Second() B {
+	return t.s
+}
+
+// Third return third value
+func (t Triples[A, B, C]) Third() C {
+	return t.t
+}
+
+// Expand the triples
+func (t Triples[A, B, C]) Expand() (A, B, C) {
+	return t.First(), t.Second(), t.Third()
+}
+
+func (t Triples[A, B, C]) String() string {
+	return fmt.Sprintf("(%#v, %#v, %#v)", t.f, t.s, t.t)
+}
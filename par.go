@@ -0,0 +1,160 @@
+package fp
+
+import (
+	"context"
+	"sync"
+)
+
+// Par configures the parallel operators ParMap and ParFilter.
+type Par struct {
+	// Workers number of goroutines running the callback, at least 1.
+	Workers int
+	// Buffer number of results that may be computed ahead of the consumer.
+	Buffer int
+	// Unordered yield results as soon as they are ready instead of in input order.
+	Unordered bool
+}
+
+type parResult[E any] struct {
+	index int
+	value E
+	keep  bool
+	panic any
+}
+
+// ParMap like Map, but run 'fn' on Par.Workers goroutines. A panic in 'fn' is
+// re-raised on the goroutine calling the iterator. The goroutines exit when
+// the iterator is exhausted or the context is done, cancel the context if the
+// iterator may be abandoned before the end.
+// this function is lazy, the goroutines start on the first call.
+func ParMap[ER, E any](ctx context.Context, next Next[E], par Par, fn func(E) ER) Next[ER] {
+	return parallel(ctx, next, par, func(e E) (ER, bool) {
+		return fn(e), true
+	})
+}
+
+// ParFilter like Filter, but run 'fn' on Par.Workers goroutines, see ParMap.
+// this function is lazy, the goroutines start on the first call.
+func ParFilter[E any](ctx context.Context, next Next[E], par Par, fn func(E) bool) Next[E] {
+	return parallel(ctx, next, par, func(e E) (E, bool) {
+		return e, fn(e)
+	})
+}
+
+func parallel[ER, E any](ctx context.Context, next Next[E], par Par, fn func(E) (ER, bool)) Next[ER] {
+	var (
+		workers = If(par.Workers < 1, Lazy(1), Lazy(par.Workers))
+		buffer  = If(par.Buffer < 0, Zero[int], Lazy(par.Buffer))
+		results = make(chan parResult[ER], buffer)
+		// tokens bounds the values in flight, so a slow value cannot make
+		// the ordered mode buffer without limit.
+		tokens  = make(chan None, workers+buffer)
+		pending = make(map[int]parResult[ER])
+		want    int
+		once    sync.Once
+		cancel  context.CancelFunc
+	)
+
+	var start = func() {
+		ctx, cancel = context.WithCancel(ctx)
+		var jobs = make(chan Pairs[int, E])
+		run, wait := Async().Expand()
+
+		go func() {
+			defer close(jobs)
+			for i := 0; ; i++ {
+				select {
+				case tokens <- None{}:
+				case <-ctx.Done():
+					return
+				}
+
+				e, ok := next()
+				if Not(ok) {
+					return
+				}
+
+				select {
+				case jobs <- Pair(i, e):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		for w := 0; w < workers; w++ {
+			run(func() {
+				for job := range jobs {
+					var r = parCall(job, fn)
+					select {
+					case results <- r:
+					case <-ctx.Done():
+						return
+					}
+				}
+			})
+		}
+
+		go func() {
+			wait()
+			close(results)
+		}()
+	}
+
+	var yield = func(r parResult[ER]) {
+		<-tokens
+		if r.panic != nil {
+			cancel()
+			panic(r.panic)
+		}
+	}
+
+	return func() (ER, bool) {
+		once.Do(start)
+		for {
+			if r, ok := pending[want]; ok && Not(par.Unordered) {
+				delete(pending, want)
+				want++
+				yield(r)
+				if r.keep {
+					return r.value, true
+				}
+
+				continue
+			}
+
+			select {
+			case r, ok := <-results:
+				if Not(ok) {
+					cancel()
+					return Zero[ER](), false
+				}
+
+				if Not(par.Unordered) {
+					pending[r.index] = r
+					continue
+				}
+
+				yield(r)
+				if r.keep {
+					return r.value, true
+				}
+			case <-ctx.Done():
+				cancel()
+				return Zero[ER](), false
+			}
+		}
+	}
+}
+
+func parCall[ER, E any](job Pairs[int, E], fn func(E) (ER, bool)) (r parResult[ER]) {
+	r.index = job.Key()
+	defer func() {
+		if p := recover(); p != nil {
+			r.panic = p
+		}
+	}()
+
+	r.value, r.keep = fn(job.Value())
+	return
+}
@@ -0,0 +1,70 @@
+package fp
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestPar(t *testing.T) {
+	var slow = func(i int) int {
+		time.Sleep(time.Duration(10-i) * time.Millisecond)
+		return i * i
+	}
+
+	t.Run("ParMap", func(t *testing.T) {
+		noLeak(t, func() {
+			var s = Slice(ParMap[int](context.Background(), Range(10), Par{Workers: 4}, slow))
+			if !reflect.DeepEqual(s, []int{0, 1, 4, 9, 16, 25, 36, 49, 64, 81}) {
+				t.Error("Not equal", s)
+			}
+		})
+	})
+
+	t.Run("Unordered", func(t *testing.T) {
+		var s = Slice(ParMap[int](context.Background(), Range(10), Par{Workers: 4, Buffer: 2, Unordered: true}, slow))
+		sort.Ints(s)
+		if !reflect.DeepEqual(s, []int{0, 1, 4, 9, 16, 25, 36, 49, 64, 81}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("ParFilter", func(t *testing.T) {
+		var s = Slice(ParFilter(context.Background(), Range(10), Par{Workers: 3}, func(i int) bool {
+			return i%3 == 0
+		}))
+
+		if !reflect.DeepEqual(s, []int{0, 3, 6, 9}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		noLeak(t, func() {
+			defer func() {
+				if p := recover(); p != "boom" {
+					t.Error("expected panic", p)
+				}
+			}()
+
+			Slice(ParMap[int](context.Background(), Range(10), Par{Workers: 2}, func(i int) int {
+				if i == 5 {
+					panic("boom")
+				}
+
+				return i
+			}))
+		})
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		noLeak(t, func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			var next = ParMap[int](ctx, Iota[int](), Par{Workers: 4}, Id[int])
+			Slice(Take(3, next))
+			cancel()
+		})
+	})
+}
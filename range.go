@@ -0,0 +1,231 @@
+package fp
+
+import (
+	"fmt"
+	"math"
+)
+
+// Ranges a range of numbers from start to stop by step, stop is excluded
+// unless the range is inclusive. The values are computed as start + i*step,
+// so float ranges do not drift and integer ranges never wrap at the bounds
+// of the type.
+type Ranges[N Real] struct {
+	start, stop, step N
+	inclusive         bool
+}
+
+// Range generate a range of integers, similar to Python built-in 'range' function.
+//
+//	Range(3) -> 0 -> 1 -> 2
+//	Range(1, 3) -> 1 -> 2
+//	Range(10, 0, -4) -> 10 -> 6 -> 2
+func Range[N Integer](r ...N) Next[N] {
+	return RangeOf(r...).Next()
+}
+
+// RangeOf make a range like Range, the step may be negative, panics if it is
+// zero.
+func RangeOf[N Real](r ...N) Ranges[N] {
+	switch len(r) {
+	case 0:
+		panic(ErrLeastOne)
+	case 1:
+		return Ranges[N]{Zero[N](), r[0], 1, false}
+	case 2:
+		return Ranges[N]{r[0], r[1], 1, false}
+	default:
+		if r[2] == 0 {
+			panic(ErrZeroStep)
+		}
+
+		return Ranges[N]{r[0], r[1], r[2], false}
+	}
+}
+
+// FloatRange make a range of floats, see RangeOf.
+//
+//	FloatRange(0, 1, 0.25) -> 0 -> 0.25 -> 0.5 -> 0.75
+func FloatRange[F Float](r ...F) Ranges[F] {
+	return RangeOf(r...)
+}
+
+// Inclusive return a copy of the range that includes stop.
+func (r Ranges[N]) Inclusive() Ranges[N] {
+	r.inclusive = true
+	return r
+}
+
+// Start first value of the range, if the range is not empty.
+func (r Ranges[N]) Start() N {
+	return r.start
+}
+
+// Stop bound of the range.
+func (r Ranges[N]) Stop() N {
+	return r.stop
+}
+
+// Step between the values of the range.
+func (r Ranges[N]) Step() N {
+	return r.step
+}
+
+// Len number of values in the range, saturates at math.MaxInt if there are
+// more, such as an endless float range.
+func (r Ranges[N]) Len() int {
+	var last, ok = r.last()
+	switch {
+	case !ok:
+		return 0
+	case last >= math.MaxInt:
+		return math.MaxInt
+	default:
+		return int(last + 1)
+	}
+}
+
+// last index of the range, false if it is empty. An endless float range has
+// the last index math.MaxUint64.
+func (r Ranges[N]) last() (uint64, bool) {
+	if IsFloat[N]() {
+		return r.floatLast()
+	}
+
+	// the distances are computed modulo 2^64, which is exact for any range
+	// of a type of at most 64 bits.
+	var dist, step = uint64(r.stop) - uint64(r.start), uint64(r.step)
+	if r.step < 0 {
+		dist, step = uint64(r.start)-uint64(r.stop), -step
+	}
+
+	switch {
+	case r.step > 0 && r.start > r.stop, r.step < 0 && r.start < r.stop:
+		return 0, false
+	case r.inclusive:
+		return dist / step, true
+	case dist == 0:
+		return 0, false
+	default:
+		return (dist - 1) / step, true
+	}
+}
+
+func (r Ranges[N]) floatLast() (uint64, bool) {
+	var start, stop, step = float64(r.start), float64(r.stop), float64(r.step)
+	switch {
+	case math.IsNaN(start) || math.IsInf(start, 0) || math.IsNaN(step) || math.IsInf(step, 0) || math.IsNaN(stop):
+		return 0, false
+	case math.IsInf(stop, 0):
+		return math.MaxUint64, (stop > 0) == (step > 0)
+	}
+
+	var n = math.Max(0, math.Ceil((stop-start)/step))
+	if r.inclusive {
+		n = math.Max(0, math.Floor((stop-start)/step)+1)
+	}
+
+	if n >= 1<<53 {
+		// the values are not distinct anymore, the range is endless.
+		return math.MaxUint64, true
+	}
+
+	// correct the rounding of the division against the values themselves.
+	for n > 0 && Not(r.in(r.at(uint64(n)-1))) {
+		n--
+	}
+
+	for r.in(r.at(uint64(n))) {
+		n++
+	}
+
+	return uint64(n) - 1, n > 0
+}
+
+// in check the value is before stop in the direction of step.
+func (r Ranges[N]) in(v N) bool {
+	switch {
+	case r.step > 0 && r.inclusive:
+		return v <= r.stop
+	case r.step > 0:
+		return v < r.stop
+	case r.inclusive:
+		return v >= r.stop
+	default:
+		return v > r.stop
+	}
+}
+
+func (r Ranges[N]) at(i uint64) N {
+	return r.start + N(i)*r.step
+}
+
+// Contains check if 'v' is a value of the range.
+func (r Ranges[N]) Contains(v N) bool {
+	var last, ok = r.last()
+	if !ok {
+		return false
+	}
+
+	if IsFloat[N]() {
+		var i = math.Round(float64(v-r.start) / float64(r.step))
+		return i >= 0 && i <= float64(last) && r.at(uint64(i)) == v
+	}
+
+	var dist, step = uint64(v) - uint64(r.start), uint64(r.step)
+	if r.step < 0 {
+		dist, step = uint64(r.start)-uint64(v), -step
+	}
+
+	var before = If(r.step > 0, func() bool { return v < r.start }, func() bool { return v > r.start })
+	return Not(before) && dist%step == 0 && dist/step <= last
+}
+
+// Next iterate the range, an endless float range never ends.
+// this function is lazy.
+func (r Ranges[N]) Next() Next[N] {
+	var i uint64
+	var last, ok = r.last()
+	return func() (N, bool) {
+		if !ok {
+			return Zero[N](), false
+		}
+
+		var v = r.at(i)
+		// stop before i wraps, the last index may be math.MaxUint64.
+		ok, i = i < last, i+1
+		return v, true
+	}
+}
+
+func (r Ranges[N]) String() string {
+	return fmt.Sprintf("[%v, %v%s step %v", r.start, r.stop, If(r.inclusive, Lazy("]"), Lazy(")")), r.step)
+}
+
+// Linspace 'n' evenly spaced floats from start to stop, both included, similar
+// to numpy 'linspace'.
+// this function is lazy.
+func Linspace[F Float](start, stop F, n int) Next[F] {
+	var i = -1
+	return func() (F, bool) {
+		i++
+		switch {
+		case i >= n:
+			return Zero[F](), false
+		case i == 0:
+			return start, true
+		case i == n-1:
+			return stop, true
+		default:
+			return start + (stop-start)*F(i)/F(n-1), true
+		}
+	}
+}
+
+// Logspace 'n' floats evenly spaced on a log scale from base^start to
+// base^stop, both included, similar to numpy 'logspace'.
+// this function is lazy.
+func Logspace[F Float](start, stop F, n int, base F) Next[F] {
+	return Map[F](Linspace(start, stop, n), func(e F) F {
+		return F(math.Pow(float64(base), float64(e)))
+	})
+}
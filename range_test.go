@@ -0,0 +1,111 @@
+package fp
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	t.Run("Range", func(t *testing.T) {
+		if s := Slice(Range(3)); !reflect.DeepEqual(s, []int{0, 1, 2}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(Range(10, 0, -4)); !reflect.DeepEqual(s, []int{10, 6, 2}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(Range[uint](3, 1)); len(s) != 0 {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Bounds", func(t *testing.T) {
+		if s := Slice(RangeOf[int8](125, 127).Inclusive().Next()); !reflect.DeepEqual(s, []int8{125, 126, 127}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(RangeOf[uint8](2, 0, 255).Inclusive().Next()); len(s) != 0 {
+			t.Error("Not equal", s)
+		}
+
+		if n := RangeOf[int8](-128, 127, 1).Inclusive().Len(); n != 256 {
+			t.Error("Not equal", n)
+		}
+
+		if s := Slice(RangeOf[int8](-100, 100, 100).Next()); !reflect.DeepEqual(s, []int8{-100, 0}) {
+			t.Error("Not equal", s)
+		}
+
+		if v, ok := Range[uint64](1 << 63)(); !ok || v != 0 {
+			t.Error("Not equal", v, ok)
+		}
+
+		if n := RangeOf[int64](math.MinInt64, math.MaxInt64).Len(); n != math.MaxInt {
+			t.Error("Not equal", n)
+		}
+
+		var full = RangeOf[uint64](0, math.MaxUint64).Inclusive()
+		if n := full.Len(); n != math.MaxInt || !full.Contains(math.MaxUint64) {
+			t.Error("Not equal", n)
+		}
+
+		if s := Slice(Take(2, Skip(126, RangeOf[uint8](0, 255).Inclusive().Next()))); !reflect.DeepEqual(s, []uint8{126, 127}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(RangeOf[uint8](250, 255).Inclusive().Next()); len(s) != 6 || s[5] != 255 {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Contains", func(t *testing.T) {
+		var r = RangeOf(10, 0, -3)
+		if !r.Contains(10) || !r.Contains(1) || r.Contains(0) || r.Contains(13) || r.Contains(5) || r.Len() != 4 {
+			t.Error("Not equal", r)
+		}
+	})
+
+	t.Run("FloatRange", func(t *testing.T) {
+		var r = FloatRange(0, 1, 0.1)
+		if r.Len() != 10 || r.Inclusive().Len() != 11 {
+			t.Error("Not equal", r.Len(), r.Inclusive().Len())
+		}
+
+		if s := Slice(r.Next()); math.Abs(s[9]-0.9) > 1e-15 {
+			t.Error("drift", s[9])
+		}
+
+		if !r.Contains(0.5) || r.Contains(0.55) {
+			t.Error("Not equal")
+		}
+
+		var endless = FloatRange(0, math.Inf(1), 1)
+		if s := Slice(Take(3, endless.Next())); endless.Len() != math.MaxInt || !reflect.DeepEqual(s, []float64{0, 1, 2}) {
+			t.Error("Not equal", s)
+		}
+
+		if FloatRange(0, math.Inf(-1), 1).Len() != 0 || FloatRange(math.NaN(), 1, 1).Len() != 0 || FloatRange(0, 1, math.Inf(1)).Len() != 0 {
+			t.Error("Not equal")
+		}
+
+		if n := FloatRange(0, 1e300, 1).Len(); n != math.MaxInt {
+			t.Error("Not equal", n)
+		}
+	})
+
+	t.Run("Linspace", func(t *testing.T) {
+		if s := Slice(Linspace(0.0, 1, 5)); !reflect.DeepEqual(s, []float64{0, 0.25, 0.5, 0.75, 1}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(Linspace(2.0, 5, 1)); !reflect.DeepEqual(s, []float64{2}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(Logspace(0.0, 3, 4, 10)); !reflect.DeepEqual(s, []float64{1, 10, 100, 1000}) {
+			t.Error("Not equal", s)
+		}
+	})
+}
@@ -0,0 +1,170 @@
+package result
+
+// Package result implements a value or an error, so fallible steps can be
+// chained in functional pipelines.
+//
+//	port := result.Map(result.Try(func() (string, error) { return os.Getenv("PORT"), nil }), strconv.Atoi)
+import (
+	"fmt"
+
+	"github.com/molikatty/fp"
+)
+
+// Result either holds a value (Ok) or an error (Err), the zero value is Ok
+// with the zero value.
+type Result[T any] struct {
+	v   T
+	err error
+}
+
+// Ok a result holding 't'.
+func Ok[T any](t T) Result[T] {
+	return Result[T]{v: t}
+}
+
+// Err a result holding 'err', which must not be nil.
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		panic("result: Err with a nil error")
+	}
+
+	return Result[T]{err: err}
+}
+
+// Of a result from the return values of a fallible function.
+//
+//	Of(strconv.Atoi(s))
+func Of[T any](t T, err error) Result[T] {
+	return fp.If(err == nil, fp.Lazy(Ok(t)), func() Result[T] { return Err[T](err) })
+}
+
+// Try call 'fn' and hold its result, a panic of 'fn' is recovered as an error.
+func Try[T any](fn func() (T, error)) (r Result[T]) {
+	defer func() {
+		if p := recover(); p != nil {
+			r = Err[T](fp.If(fp.Is[error](p), func() error { return fp.AnyTo[error](p) }, func() error {
+				return fmt.Errorf("panic: %v", p)
+			}))
+		}
+	}()
+
+	return Of(fn())
+}
+
+// Map the value of the result with a fallible function, an error stays.
+//
+//	Map(Ok("42"), strconv.Atoi)
+func Map[R, T any](r Result[T], fn func(T) (R, error)) Result[R] {
+	return fp.If(r.IsOk(), func() Result[R] { return Of(fn(r.v)) }, func() Result[R] { return Err[R](r.err) })
+}
+
+// FlatMap the value of the result to another result, an error stays.
+func FlatMap[R, T any](r Result[T], fn func(T) Result[R]) Result[R] {
+	return fp.If(r.IsOk(), func() Result[R] { return fn(r.v) }, func() Result[R] { return Err[R](r.err) })
+}
+
+// Collect the values of the results into one result, the first error if any.
+func Collect[T any](rs []Result[T]) Result[[]T] {
+	var s = make([]T, 0, len(rs))
+	for i := range rs {
+		if rs[i].IsErr() {
+			return Err[[]T](rs[i].err)
+		}
+
+		s = append(s, rs[i].v)
+	}
+
+	return Ok(s)
+}
+
+// Partition split an iterator of results into the values and the errors.
+func Partition[T any](next fp.Next[Result[T]]) (values []T, errs []error) {
+	values, errs = make([]T, 0), make([]error, 0)
+	fp.Loop(next, func(r Result[T]) {
+		if r.IsErr() {
+			errs = append(errs, r.err)
+			return
+		}
+
+		values = append(values, r.v)
+	})
+
+	return
+}
+
+// FromTry convert a fp.TryNext to an iterator of results, the error that ends
+// the fp.TryNext is its last result.
+// this function is lazy.
+func FromTry[T any](next fp.TryNext[T]) fp.Next[Result[T]] {
+	var done bool
+	return func() (Result[T], bool) {
+		if done {
+			return fp.Zero[Result[T]](), false
+		}
+
+		t, ok, err := next()
+		switch {
+		case err != nil:
+			done = true
+			return Err[T](err), true
+		case fp.Not(ok):
+			done = true
+			return fp.Zero[Result[T]](), false
+		}
+
+		return Ok(t), true
+	}
+}
+
+// IsOk check the result holds a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr check the result holds an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Get the value and the error, like the return values of a fallible function.
+func (r Result[T]) Get() (T, error) {
+	return r.v, r.err
+}
+
+// Err the error of the result, nil if it is Ok.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap get the value, panics with the error if the result is Err.
+func (r Result[T]) Unwrap() T {
+	if r.IsErr() {
+		panic(r.err)
+	}
+
+	return r.v
+}
+
+// OrElse get the value, or 't' if the result is Err.
+func (r Result[T]) OrElse(t T) T {
+	return fp.If(r.IsOk(), fp.Lazy(r.v), fp.Lazy(t))
+}
+
+// MapErr map the error of the result, a value stays. If 'fn' return nil the
+// error is swallowed and the result is Ok with the zero value.
+//
+//	r.MapErr(func(err error) error { return fmt.Errorf("load config: %w", err) })
+func (r Result[T]) MapErr(fn func(error) error) Result[T] {
+	return fp.If(r.IsOk(), fp.Lazy(r), func() Result[T] { return Of(fp.Zero[T](), fn(r.err)) })
+}
+
+// Recover turn an error into a value, a value stays.
+func (r Result[T]) Recover(fn func(error) T) Result[T] {
+	return fp.If(r.IsOk(), fp.Lazy(r), func() Result[T] { return Ok(fn(r.err)) })
+}
+
+func (r Result[T]) String() string {
+	return fp.If(r.IsOk(), func() string { return fmt.Sprintf("Ok(%v)", r.v) }, func() string {
+		return fmt.Sprintf("Err(%v)", r.err)
+	})
+}
@@ -0,0 +1,79 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/molikatty/fp"
+	"github.com/molikatty/fp/slice"
+)
+
+func TestExample(t *testing.T) {
+	t.Run("Map", func(t *testing.T) {
+		if n := Map(Ok("42"), strconv.Atoi).Unwrap(); n != 42 {
+			t.Error("Not equal", n)
+		}
+
+		var r = Map(Ok("x"), strconv.Atoi).MapErr(func(err error) error { return fmt.Errorf("port: %w", err) })
+		if !errors.Is(r.Err(), strconv.ErrSyntax) || r.OrElse(80) != 80 {
+			t.Error("Not equal", r)
+		}
+
+		if n := r.Recover(func(error) int { return 8080 }).Unwrap(); n != 8080 {
+			t.Error("Not equal", n)
+		}
+
+		if r = r.MapErr(func(error) error { return nil }); !r.IsOk() || r.Unwrap() != 0 {
+			t.Error("Not equal", r)
+		}
+	})
+
+	t.Run("Try", func(t *testing.T) {
+		var r = Try(func() (int, error) { panic("boom") })
+		if r.IsOk() || r.Err().Error() != "panic: boom" {
+			t.Error("Not equal", r)
+		}
+
+		var half = func(n int) Result[int] {
+			return fp.If(n%2 == 0, func() Result[int] { return Ok(n / 2) }, func() Result[int] { return Err[int](errors.New("odd")) })
+		}
+
+		if FlatMap(Ok(4), half).Unwrap() != 2 || FlatMap(Ok(3), half).IsOk() {
+			t.Error("Not equal")
+		}
+	})
+
+	t.Run("Collect", func(t *testing.T) {
+		if s := Collect([]Result[int]{Ok(1), Ok(2)}).Unwrap(); !reflect.DeepEqual(s, []int{1, 2}) {
+			t.Error("Not equal", s)
+		}
+
+		if Collect([]Result[int]{Ok(1), Of(strconv.Atoi("x"))}).IsOk() {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("Partition", func(t *testing.T) {
+		var next = fp.Map[Result[int]](slice.Iter(slice.Of("1", "x", "3")), func(s string) Result[int] {
+			return Of(strconv.Atoi(s))
+		})
+
+		values, errs := Partition(next)
+		if !reflect.DeepEqual(values, []int{1, 3}) || len(errs) != 1 {
+			t.Error("Not equal", values, errs)
+		}
+
+		var fail = errors.New("fail")
+		var tries = fp.TryMap[int](fp.ToTry(fp.Range(5)), func(i int) (int, error) {
+			return i, fp.If(i == 2, fp.Lazy(fail), fp.Zero[error])
+		})
+
+		values, errs = Partition(FromTry(tries))
+		if !reflect.DeepEqual(values, []int{0, 1}) || !reflect.DeepEqual(errs, []error{fail}) {
+			t.Error("Not equal", values, errs)
+		}
+	})
+}
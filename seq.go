@@ -0,0 +1,71 @@
+//go:build go1.23
+
+package fp
+
+import "iter"
+
+// Seq convert the iterator to a range-over-func iterator.
+//
+//	for v := range next.Seq() {
+//	  // do something
+//	}
+func (next Next[E]) Seq() iter.Seq[E] {
+	return Seq(next)
+}
+
+// Seq convert an iterator to an iter.Seq, the iteration is driven by the
+// 'range' statement, no goroutine is started.
+func Seq[E any](next Next[E]) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		ForEach(next, yield)
+	}
+}
+
+// Seq2 convert an iterator of pairs to an iter.Seq2.
+func Seq2[K, V any](next Next[Pairs[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		ForEach(next, func(p Pairs[K, V]) bool {
+			return yield(p.Expand())
+		})
+	}
+}
+
+// Pull convert an iter.Seq to an iterator, 'stop' must be called if the
+// iterator is not exhausted, otherwise the underlying iter.Seq stays suspended.
+// Like iter.Pull, the returned iterator is not concurrent-safe, see Lock.
+func Pull[E any](seq iter.Seq[E]) (Next[E], func()) {
+	var next, stop = iter.Pull(seq)
+	return next, stop
+}
+
+// Pull2 convert an iter.Seq2 to an iterator of pairs, 'stop' must be called
+// if the iterator is not exhausted.
+func Pull2[K, V any](seq iter.Seq2[K, V]) (Next[Pairs[K, V]], func()) {
+	var next, stop = iter.Pull2(seq)
+	return func() (Pairs[K, V], bool) {
+		k, v, ok := next()
+		if Not(ok) {
+			return Zero[Pairs[K, V]](), false
+		}
+
+		return Pair(k, v), true
+	}, stop
+}
+
+// FromSeq convert an iter.Seq to an iterator, the underlying iter.Seq is
+// released when the iterator is exhausted. Use Pull if the iterator may be
+// abandoned before the end.
+func FromSeq[E any](seq iter.Seq[E]) Next[E] {
+	return OnClose(Pull(seq)).Key()
+}
+
+// FromSeq2 convert an iter.Seq2 to an iterator of pairs, the underlying
+// iter.Seq2 is released when the iterator is exhausted.
+func FromSeq2[K, V any](seq iter.Seq2[K, V]) Next[Pairs[K, V]] {
+	return OnClose(Pull2(seq)).Key()
+}
+
+// Seq convert the range to a range-over-func iterator.
+func (r Ranges[N]) Seq() iter.Seq[N] {
+	return Seq(r.Next())
+}
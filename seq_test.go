@@ -0,0 +1,73 @@
+//go:build go1.23
+
+package fp
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSeq(t *testing.T) {
+	t.Run("Seq", func(t *testing.T) {
+		var s []int
+		for v := range Range(5).Seq() {
+			s = append(s, v)
+		}
+
+		if !reflect.DeepEqual(s, []int{0, 1, 2, 3, 4}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("SeqBreak", func(t *testing.T) {
+		var next = Range(10)
+		for v := range next.Seq() {
+			if v == 2 {
+				break
+			}
+		}
+
+		if v, _ := next(); v != 3 {
+			t.Error("Not equal", v)
+		}
+	})
+
+	t.Run("Seq2", func(t *testing.T) {
+		var next = Map[Pairs[int, string]](Range(3), func(i int) Pairs[int, string] {
+			return Pair(i, string(rune('a'+i)))
+		})
+
+		var m = maps.Collect(Seq2(next))
+		if !reflect.DeepEqual(m, map[int]string{0: "a", 1: "b", 2: "c"}) {
+			t.Error("Not equal", m)
+		}
+	})
+
+	t.Run("FromSeq", func(t *testing.T) {
+		var s = Slice(Filter(FromSeq(slices.Values([]int{1, 2, 3, 4})), func(i int) bool {
+			return i%2 == 0
+		}))
+
+		if !reflect.DeepEqual(s, []int{2, 4}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Pull", func(t *testing.T) {
+		var next, stop = Pull(slices.Values([]int{1, 2, 3}))
+		defer stop()
+
+		if s := Slice(Take(2, next)); !reflect.DeepEqual(s, []int{1, 2}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("FromSeq2", func(t *testing.T) {
+		var m = KV(FromSeq2(maps.All(map[string]int{"a": 1, "b": 2})))
+		if !reflect.DeepEqual(m, map[string]int{"a": 1, "b": 2}) {
+			t.Error("Not equal", m)
+		}
+	})
+}
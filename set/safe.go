@@ -6,6 +6,7 @@ import (
 	"sync/atomic"
 
 	"github.com/molikatty/fp"
+	"github.com/molikatty/fp/option"
 	"github.com/molikatty/fp/str"
 )
 
@@ -73,6 +74,21 @@ func (s *_safe[K]) Pop() (t K) {
 	return
 }
 
+func (s *_safe[K]) popOpt() option.Option[K] {
+	var pop = option.None[K]()
+	s.set.Range(func(k, _ any) bool {
+		// another goroutine may have popped it since the Range saw it.
+		if _, ok := s.set.LoadAndDelete(k); ok {
+			s.len.Add(-1)
+			pop = option.Some(fp.AnyTo[K](k))
+		}
+
+		return pop.IsNone()
+	})
+
+	return pop
+}
+
 func (s *_safe[K]) Has(t K) bool {
 	_, ok := s.set.Load(t)
 	return ok
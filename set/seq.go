@@ -0,0 +1,12 @@
+//go:build go1.23
+
+package set
+
+import "iter"
+
+// Seq make an iter.Seq for set, no goroutine is started.
+func Seq[K comparable](set Set[K]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		set.ForEach(yield)
+	}
+}
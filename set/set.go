@@ -2,7 +2,8 @@ package set
 
 import (
 	"github.com/molikatty/fp"
-	"github.com/molikatty/fp/channel"
+	"github.com/molikatty/fp/option"
+	"github.com/molikatty/fp/slice"
 )
 
 type (
@@ -77,19 +78,33 @@ func From[U Safe | Unsafe, K comparable](next fp.Next[K]) Set[K] {
 	return s
 }
 
+// Iter make an iterator for set, the iterator walks a snapshot of the set
+// taken when it is called, so no goroutine is left behind if it is abandoned.
 func Iter[K comparable](set Set[K]) fp.Next[K] {
-	var y = channel.Lazy(make(chan K), func(y chan K) {
-		set.ForEach(func(k K) bool {
-			y <- k
-			return true
-		})
-	})
+	return slice.Iter(set.Slice())
+}
+
+// PopOpt like Set.Pop, but return None if the set is empty, to tell it from
+// a zero value element. It is atomic on a Safe set.
+func PopOpt[K comparable](set Set[K]) option.Option[K] {
+	if p, ok := set.(popper[K]); ok {
+		return p.popOpt()
+	}
 
-	return func() (K, bool) {
-		for v := range y {
-			return v, true
-		}
+	var pop = option.None[K]()
+	set.ForEach(func(k K) bool {
+		pop = option.Some(k)
+		return false
+	})
 
-		return fp.Zero[K](), false
+	if k, ok := pop.Get(); ok {
+		set.Del(k)
 	}
+
+	return pop
+}
+
+// popper implemented by the sets of this package to pop in one step.
+type popper[K comparable] interface {
+	popOpt() option.Option[K]
 }
@@ -1,8 +1,11 @@
 package set
 
 import (
+	"runtime"
 	"sync"
 	"testing"
+
+	"github.com/molikatty/fp"
 )
 
 func TestExample(t *testing.T) {
@@ -27,6 +30,44 @@ func TestExample(t *testing.T) {
 		t.Log(set)
 	})
 
+	t.Run("IterTake", func(t *testing.T) {
+		var before = runtime.NumGoroutine()
+		var next = Iter(Of[Safe](1, 2, 3))
+		if len(fp.Slice(fp.Take(1, next))) != 1 || runtime.NumGoroutine() > before {
+			t.Error("goroutine leak")
+		}
+	})
+
+	t.Run("PopOpt", func(t *testing.T) {
+		var s = Of[Unsafe](0)
+		if PopOpt(s).Unwrap() != 0 || PopOpt(s).IsSome() {
+			t.Error("Not equal")
+		}
+	})
+
+	t.Run("PopOptSafe", func(t *testing.T) {
+		var s = Of[Safe](fp.Slice(fp.Range(100))...)
+		var popped = Of[Safe, int]()
+		var wg sync.WaitGroup
+		wg.Add(10)
+		for i := 0; i < 10; i++ {
+			go func() {
+				defer wg.Done()
+				for k, ok := PopOpt(s).Get(); ok; k, ok = PopOpt(s).Get() {
+					if popped.Has(k) {
+						t.Error("popped twice", k)
+					}
+					popped.Add(k)
+				}
+			}()
+		}
+
+		wg.Wait()
+		if popped.Len() != 100 || s.Len() != 0 {
+			t.Error("Not equal", popped.Len(), s.Len())
+		}
+	})
+
 	t.Run("Difference", func(t *testing.T) {
 		var d = Of[Unsafe](1, 2)
 		var e = Of[Unsafe](1, 2, 3)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/molikatty/fp"
+	"github.com/molikatty/fp/option"
 	"github.com/molikatty/fp/str"
 )
 
@@ -46,6 +47,15 @@ func (s _unsafe[K]) Pop() (t K) {
 	return
 }
 
+func (s _unsafe[K]) popOpt() option.Option[K] {
+	for k := range s {
+		s.Del(k)
+		return option.Some(k)
+	}
+
+	return option.None[K]()
+}
+
 func (s _unsafe[K]) IsSafe() bool {
 	return false
 }
@@ -0,0 +1,16 @@
+//go:build go1.23
+
+package slice
+
+import "iter"
+
+// Seq make an iter.Seq for slice
+func Seq[S ~[]T, T any](t S) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range t {
+			if !yield(t[i]) {
+				return
+			}
+		}
+	}
+}
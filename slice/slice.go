@@ -3,6 +3,7 @@ package slice
 // Package slices defines various functions useful with slices of any type.
 import (
 	"github.com/molikatty/fp"
+	"github.com/molikatty/fp/option"
 )
 
 type (
@@ -159,6 +160,12 @@ func IndexFunc[S ~[]T, T any](vs S, fn func(T) bool) int {
 	return -1
 }
 
+// Find get the first value that evaluates to 'true', None if there is none.
+func Find[S ~[]T, T any](vs S, fn func(T) bool) option.Option[T] {
+	var i = IndexFunc(vs, fn)
+	return option.Of(fp.Def(i >= 0, func() T { return vs[i] }), i >= 0)
+}
+
 // Contains reports whether v is present in s.
 func Contains[S ~[]T, T comparable](vs S, v T) bool {
 	return Index(vs, v) >= 0
@@ -59,4 +59,11 @@ func TestExample(t *testing.T) {
 		var i3 = Iter(Of(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 13))
 		t.Log(fp.Slice(fp.Merge(i1, i2, i3)))
 	})
+
+	t.Run("Find", func(t *testing.T) {
+		var even = func(i int) bool { return i%2 == 0 }
+		if Find(Of(1, 0, 2), even).Unwrap() != 0 || Find(Of(1, 3), even).IsSome() {
+			t.Error("Not equal")
+		}
+	})
 }
@@ -0,0 +1,220 @@
+package fp
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/gob"
+	"errors"
+	"io"
+	"os"
+	"sort"
+)
+
+// Sorted materialize the iterator and iterate it in the order of 'less', the
+// sort is stable. The iterator is read on the first call.
+// this function is lazy.
+func Sorted[E any](next Next[E], less func(E, E) bool) Next[E] {
+	var sorted Next[E]
+	return func() (E, bool) {
+		if sorted == nil {
+			var s = Slice(next)
+			sort.SliceStable(s, func(i, j int) bool { return less(s[i], s[j]) })
+			sorted = iterate(s)
+		}
+
+		return sorted()
+	}
+}
+
+// TopK get the first 'k' values in the order of 'less' without sorting the
+// whole iterator, only 'k' values are kept in memory.
+func TopK[E any](next Next[E], k int, less func(E, E) bool) []E {
+	if k < 1 {
+		return make([]E, 0)
+	}
+
+	// a max-heap of the k smallest values seen so far, the negated index puts
+	// the latest of equal values on top, so that the earlier ones are kept.
+	var h = &heads[E]{less: func(a, b E) bool { return less(b, a) }}
+	var index int
+	Loop(next, func(e E) {
+		switch {
+		case h.Len() < k:
+			heap.Push(h, head[E]{e, -index})
+		case less(e, h.items[0].value):
+			h.items[0] = head[E]{e, -index}
+			heap.Fix(h, 0)
+		}
+
+		index++
+	})
+
+	var s = make([]E, h.Len())
+	for i := len(s) - 1; i >= 0; i-- {
+		s[i] = AnyTo[head[E]](heap.Pop(h)).value
+	}
+
+	return s
+}
+
+// Codec write and read back the values spilled to disk by SortExternal. The
+// decoder returns io.EOF after the last value.
+type Codec[E any] interface {
+	Encoder(io.Writer) func(E) error
+	Decoder(io.Reader) func() (E, error)
+}
+
+type gobCodec[E any] struct{}
+
+// Gob a Codec using encoding/gob.
+func Gob[E any]() Codec[E] {
+	return gobCodec[E]{}
+}
+
+func (gobCodec[E]) Encoder(w io.Writer) func(E) error {
+	var enc = gob.NewEncoder(w)
+	return func(e E) error {
+		return enc.Encode(e)
+	}
+}
+
+func (gobCodec[E]) Decoder(r io.Reader) func() (E, error) {
+	var dec = gob.NewDecoder(r)
+	return func() (e E, err error) {
+		err = dec.Decode(&e)
+		return
+	}
+}
+
+type sortRun struct {
+	file *os.File
+	err  error
+}
+
+// SortExternal sort an iterator that may not fit in memory, at most 'memLimit'
+// values are held in memory: sorted runs of 'memLimit' values are spilled to
+// temporary files with 'codec' and merged back lazily, the sort is stable. If
+// the iterator has at most 'memLimit' values, nothing is written to disk.
+//
+// The temporary files are removed when the iterator is exhausted or fails,
+// call Close if it is abandoned before the end.
+// this function is lazy, the iterator is read and spilled on the first call.
+func SortExternal[E any](next Next[E], less func(E, E) bool, codec Codec[E], memLimit int) Pairs[TryNext[E], Close] {
+	if memLimit < 1 {
+		panic(ErrNotPositive)
+	}
+
+	var (
+		runs   []*sortRun
+		merged Next[E]
+		err    error
+		closed bool
+	)
+
+	var cleanup = func() {
+		closed = true
+		for _, r := range runs {
+			r.file.Close()
+			os.Remove(r.file.Name())
+		}
+
+		runs = nil
+	}
+
+	var spill = func(s []E) error {
+		f, err := os.CreateTemp("", "fp-sort-*")
+		if err != nil {
+			return err
+		}
+
+		runs = append(runs, &sortRun{file: f})
+		var w = bufio.NewWriter(f)
+		var enc = codec.Encoder(w)
+		for i := range s {
+			if err = enc(s[i]); err != nil {
+				return err
+			}
+		}
+
+		if err = w.Flush(); err != nil {
+			return err
+		}
+
+		_, err = f.Seek(0, io.SeekStart)
+		return err
+	}
+
+	var read = func(r *sortRun) Next[E] {
+		var dec = codec.Decoder(bufio.NewReader(r.file))
+		return func() (E, bool) {
+			e, err := dec()
+			if err != nil && Not(errors.Is(err, io.EOF)) {
+				r.err = err
+			}
+
+			return e, err == nil
+		}
+	}
+
+	var prepare = func() error {
+		var chunks = Chunk(next, memLimit)
+		for chunk, ok := chunks(); ok; chunk, ok = chunks() {
+			sort.SliceStable(chunk, func(i, j int) bool { return less(chunk[i], chunk[j]) })
+			if len(runs) == 0 && len(chunk) < memLimit {
+				merged = iterate(chunk)
+				return nil
+			}
+
+			if len(runs) == 0 {
+				// peek one value, the first run may be the only one.
+				e, more := next()
+				if Not(more) {
+					merged = iterate(chunk)
+					return nil
+				}
+
+				chunks = Chunk(Chain(iterate([]E{e}), next), memLimit)
+			}
+
+			if err := spill(chunk); err != nil {
+				return err
+			}
+		}
+
+		var nexts = make([]Next[E], len(runs))
+		for i := range runs {
+			nexts[i] = read(runs[i])
+		}
+
+		merged = MergeSorted(less, nexts...)
+		return nil
+	}
+
+	return Pair[TryNext[E], Close](func() (E, bool, error) {
+		if closed {
+			return Zero[E](), false, err
+		}
+
+		if merged == nil {
+			if err = prepare(); err != nil {
+				cleanup()
+				return Zero[E](), false, err
+			}
+		}
+
+		e, ok := merged()
+		for _, r := range runs {
+			if r.err != nil {
+				err = r.err
+				cleanup()
+				return Zero[E](), false, err
+			}
+		}
+
+		if Not(ok) {
+			cleanup()
+		}
+
+		return e, ok, nil
+	}, cleanup)
+}
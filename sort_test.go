@@ -0,0 +1,78 @@
+package fp
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	var less = func(a, b int) bool { return a < b }
+	var values = []int{5, 3, 9, 1, 7, 3, 8, 2, 6, 0, 4}
+
+	t.Run("Sorted", func(t *testing.T) {
+		if s := Slice(Sorted(iterate(values), less)); !reflect.DeepEqual(s, []int{0, 1, 2, 3, 3, 4, 5, 6, 7, 8, 9}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("TopK", func(t *testing.T) {
+		if s := TopK(iterate(values), 4, less); !reflect.DeepEqual(s, []int{0, 1, 2, 3}) {
+			t.Error("Not equal", s)
+		}
+
+		var byKey = func(a, b Pairs[int, string]) bool { return a.Key() < b.Key() }
+		var s = TopK(iterate([]Pairs[int, string]{Pair(1, "a"), Pair(0, "b"), Pair(1, "c"), Pair(1, "d")}), 3, byKey)
+		if !reflect.DeepEqual(s, []Pairs[int, string]{Pair(0, "b"), Pair(1, "a"), Pair(1, "c")}) {
+			t.Error("not stable", s)
+		}
+	})
+
+	t.Run("SortExternal", func(t *testing.T) {
+		var dir = t.TempDir()
+		t.Setenv("TMPDIR", dir)
+
+		next, _ := SortExternal(iterate(values), less, Gob[int](), 3).Expand()
+		if s, err := TrySlice(next); err != nil || !reflect.DeepEqual(s, []int{0, 1, 2, 3, 3, 4, 5, 6, 7, 8, 9}) {
+			t.Error("Not equal", s, err)
+		}
+
+		if files, _ := os.ReadDir(dir); len(files) != 0 {
+			t.Error("temporary files left", len(files))
+		}
+	})
+
+	t.Run("SortExternalOneRun", func(t *testing.T) {
+		var dir = t.TempDir()
+		t.Setenv("TMPDIR", dir)
+
+		next, cancel := SortExternal(Range(3), func(a, b int) bool { return a > b }, Gob[int](), 3).Expand()
+		defer cancel()
+		if e, ok, err := next(); !ok || err != nil || e != 2 {
+			t.Error("Not equal", e, err)
+		}
+
+		if files, _ := os.ReadDir(dir); len(files) != 0 {
+			t.Error("spilled a single run", len(files))
+		}
+
+		if s, err := TrySlice(next); err != nil || !reflect.DeepEqual(s, []int{1, 0}) {
+			t.Error("Not equal", s, err)
+		}
+	})
+
+	t.Run("SortExternalClose", func(t *testing.T) {
+		var dir = t.TempDir()
+		t.Setenv("TMPDIR", dir)
+
+		next, cancel := SortExternal(Range(100), func(a, b int) bool { return a > b }, Gob[int](), 10).Expand()
+		if e, ok, err := next(); !ok || err != nil || e != 99 {
+			t.Error("Not equal", e, err)
+		}
+
+		cancel()
+		if files, _ := os.ReadDir(dir); len(files) != 0 {
+			t.Error("temporary files left", len(files))
+		}
+	})
+}
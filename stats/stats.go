@@ -0,0 +1,219 @@
+package stats
+
+// Package stats computes statistics over fp.Next in a single pass where
+// possible, accumulating in float64 with numerically stable methods.
+import (
+	"math"
+	"sort"
+
+	"github.com/molikatty/fp"
+)
+
+// ErrOverflow returned by Sum when the sum of integers does not fit in the
+// type, it is fp.ErrOverflow.
+var ErrOverflow = fp.ErrOverflow
+
+// Moments running count, mean and variance of numbers using Welford's method,
+// the zero value is ready to use.
+type Moments struct {
+	n    int
+	mean float64
+	m2   float64
+}
+
+// Add a number.
+func (m *Moments) Add(x float64) {
+	m.n++
+	var delta = x - m.mean
+	m.mean += delta / float64(m.n)
+	m.m2 += delta * (x - m.mean)
+}
+
+// Count of the numbers.
+func (m *Moments) Count() int {
+	return m.n
+}
+
+// Mean of the numbers, 0 if there is none.
+func (m *Moments) Mean() float64 {
+	return m.mean
+}
+
+// Variance population variance of the numbers, 0 if there is none.
+func (m *Moments) Variance() float64 {
+	return fp.If(m.n < 1, fp.Zero[float64], func() float64 { return m.m2 / float64(m.n) })
+}
+
+// SampleVariance sample variance of the numbers, 0 if there are less than 2.
+func (m *Moments) SampleVariance() float64 {
+	return fp.If(m.n < 2, fp.Zero[float64], func() float64 { return m.m2 / float64(m.n-1) })
+}
+
+// StdDev population standard deviation of the numbers.
+func (m *Moments) StdDev() float64 {
+	return math.Sqrt(m.Variance())
+}
+
+// Describe compute the moments of the numbers.
+func Describe[N fp.Real](next fp.Next[N]) *Moments {
+	var m = new(Moments)
+	fp.Loop(next, func(n N) { m.Add(float64(n)) })
+
+	return m
+}
+
+// Mean of the numbers, false if the iterator is empty.
+func Mean[N fp.Real](next fp.Next[N]) (float64, bool) {
+	var m = Describe(next)
+	return m.Mean(), m.Count() > 0
+}
+
+// Variance population variance of the numbers, false if the iterator is empty.
+func Variance[N fp.Real](next fp.Next[N]) (float64, bool) {
+	var m = Describe(next)
+	return m.Variance(), m.Count() > 0
+}
+
+// StdDev population standard deviation of the numbers, false if the iterator
+// is empty.
+func StdDev[N fp.Real](next fp.Next[N]) (float64, bool) {
+	var m = Describe(next)
+	return m.StdDev(), m.Count() > 0
+}
+
+// Sum of the numbers, integers are checked for overflow, floats are summed
+// with compensated summation, see fp.SumCheckedFrom and fp.SumKahanFrom.
+func Sum[N fp.Real](next fp.Next[N]) (N, error) {
+	if fp.IsFloat[N]() {
+		return N(fp.SumKahanFrom(fp.Map[float64](next, func(n N) float64 { return float64(n) }))), nil
+	}
+
+	return fp.SumCheckedFrom(next)
+}
+
+// Median of the numbers, the mean of the two middle numbers for an even count,
+// false if the iterator is empty. The numbers are kept in memory.
+func Median[N fp.Real](next fp.Next[N]) (float64, bool) {
+	return Quantile(next, 0.5)
+}
+
+// Quantile the q-quantile of the numbers with linear interpolation between
+// the closest ranks, 'q' is clamped to [0, 1], false if the iterator is empty.
+// The numbers are kept in memory, see TDigest for large inputs.
+func Quantile[N fp.Real](next fp.Next[N], q float64) (float64, bool) {
+	var qs, ok = Quantiles(next, q)
+	return qs[0], ok
+}
+
+// Quantiles like Quantile, for several 'qs' with a single sort.
+func Quantiles[N fp.Real](next fp.Next[N], qs ...float64) ([]float64, bool) {
+	var s = fp.Slice(fp.Map[float64](next, func(n N) float64 { return float64(n) }))
+	var r = make([]float64, len(qs))
+	if len(s) == 0 {
+		return r, false
+	}
+
+	sort.Float64s(s)
+	for i, q := range qs {
+		var pos = math.Max(0, math.Min(1, q)) * float64(len(s)-1)
+		var lo = int(pos)
+		var hi = fp.If(lo+1 < len(s), fp.Lazy(lo+1), fp.Lazy(lo))
+		r[i] = s[lo] + (s[hi]-s[lo])*(pos-float64(lo))
+	}
+
+	return r, true
+}
+
+// Percentile the p-th percentile of the numbers, see Quantile.
+func Percentile[N fp.Real](next fp.Next[N], p float64) (float64, bool) {
+	return Quantile(next, p/100)
+}
+
+// Mode the most frequent value, the first one seen among equally frequent
+// values, false if the iterator is empty.
+func Mode[E comparable](next fp.Next[E]) (E, bool) {
+	var counts = make(map[E]int)
+	var seen = make([]E, 0)
+	fp.Loop(next, func(e E) {
+		if counts[e]++; counts[e] == 1 {
+			seen = append(seen, e)
+		}
+	})
+
+	var mode, top = fp.Zero[E](), 0
+	for _, e := range seen {
+		if counts[e] > top {
+			mode, top = e, counts[e]
+		}
+	}
+
+	return mode, top > 0
+}
+
+// Histogram count the numbers into buckets split by the ascending 'bounds',
+// the result has len(bounds)+1 counts: numbers below bounds[0], numbers in
+// [bounds[i-1], bounds[i]), and numbers from the last bound up.
+func Histogram[N fp.Real](next fp.Next[N], bounds ...N) []int {
+	var counts = make([]int, len(bounds)+1)
+	fp.Loop(next, func(n N) {
+		counts[sort.Search(len(bounds), func(i int) bool { return n < bounds[i] })]++
+	})
+
+	return counts
+}
+
+// LinearBuckets 'n' bounds starting at 'start', 'width' apart, for Histogram.
+func LinearBuckets[N fp.Real](start, width N, n int) []N {
+	var bounds = make([]N, n)
+	for i := range bounds {
+		bounds[i] = start + N(i)*width
+	}
+
+	return bounds
+}
+
+// Covariance population covariance of the pairs, false if the iterator is
+// empty.
+func Covariance[X, Y fp.Real](next fp.Next[fp.Pairs[X, Y]]) (float64, bool) {
+	var c = comoments(next)
+	return c.cov / float64(c.n), c.n > 0
+}
+
+// Correlation Pearson correlation coefficient of the pairs, false if the
+// iterator is empty or either side is constant.
+func Correlation[X, Y fp.Real](next fp.Next[fp.Pairs[X, Y]]) (float64, bool) {
+	var c = comoments(next)
+	var den = math.Sqrt(c.x.m2 * c.y.m2)
+	return c.cov / den, c.n > 0 && den != 0
+}
+
+type comoment struct {
+	n    int
+	x, y Moments
+	cov  float64
+}
+
+func comoments[X, Y fp.Real](next fp.Next[fp.Pairs[X, Y]]) (c comoment) {
+	fp.Loop(next, func(p fp.Pairs[X, Y]) {
+		var x, y = float64(p.Key()), float64(p.Value())
+		var dx = x - c.x.mean
+		c.n++
+		c.x.Add(x)
+		c.y.Add(y)
+		c.cov += dx * (y - c.y.mean)
+	})
+
+	return
+}
+
+// EMA exponential moving average of the numbers with smoothing factor
+// 'alpha' in (0, 1], seeded with the first number.
+// this function is lazy.
+func EMA[N fp.Real](next fp.Next[N], alpha float64) fp.Next[float64] {
+	var avg, started = 0.0, false
+	return fp.Map[float64](next, func(n N) float64 {
+		avg = fp.If(started, func() float64 { return avg + alpha*(float64(n)-avg) }, fp.Lazy(float64(n)))
+		started = true
+		return avg
+	})
+}
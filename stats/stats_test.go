@@ -0,0 +1,114 @@
+package stats
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/molikatty/fp"
+	"github.com/molikatty/fp/slice"
+)
+
+func TestExample(t *testing.T) {
+	var data = slice.Of(2, 4, 4, 4, 5, 5, 7, 9)
+
+	t.Run("Moments", func(t *testing.T) {
+		if m, ok := Mean(slice.Iter(data)); !ok || m != 5 {
+			t.Error("Not equal", m)
+		}
+
+		if v, ok := Variance(slice.Iter(data)); !ok || v != 4 {
+			t.Error("Not equal", v)
+		}
+
+		if s, ok := StdDev(slice.Iter(data)); !ok || s != 2 {
+			t.Error("Not equal", s)
+		}
+
+		if _, ok := Mean(fp.Range(0)); ok {
+			t.Error("empty iterator")
+		}
+	})
+
+	t.Run("Stable", func(t *testing.T) {
+		var big = fp.Map[float64](fp.Range(4), func(i int) float64 { return 1e9 + float64(i%2) })
+		if v, _ := Variance(big); math.Abs(v-0.25) > 1e-9 {
+			t.Error("Not equal", v)
+		}
+	})
+
+	t.Run("Sum", func(t *testing.T) {
+		if _, err := Sum(slice.Iter(slice.Of[int8](100, 100))); !errors.Is(err, ErrOverflow) {
+			t.Error("expected overflow", err)
+		}
+
+		if s, err := Sum(slice.Iter(slice.Of(1e16, 1.0, -1e16))); err != nil || s != 1 {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Quantile", func(t *testing.T) {
+		if m, ok := Median(slice.Iter(data)); !ok || m != 4.5 {
+			t.Error("Not equal", m)
+		}
+
+		if qs, _ := Quantiles(fp.Range(101), 0, 0.25, 1); !reflect.DeepEqual(qs, []float64{0, 25, 100}) {
+			t.Error("Not equal", qs)
+		}
+
+		if p, _ := Percentile(fp.Range(1, 5), 50); p != 2.5 {
+			t.Error("Not equal", p)
+		}
+	})
+
+	t.Run("TDigest", func(t *testing.T) {
+		var r = rand.New(rand.NewSource(1))
+		var next = fp.Take(100000, fp.Map[float64](fp.Iota[int](), func(int) float64 { return r.Float64() }))
+		var d = NewTDigest(100)
+		fp.Loop(next, d.Add)
+		for _, q := range []float64{0.01, 0.5, 0.99} {
+			if v := d.Quantile(q); math.Abs(v-q) > 0.01 {
+				t.Error("Not equal", q, v)
+			}
+		}
+
+		if v, ok := ApproxQuantile(slice.Iter(data), 0.5, 100); !ok || v != 4.5 {
+			t.Error("Not equal", v)
+		}
+	})
+
+	t.Run("Mode", func(t *testing.T) {
+		if m, ok := Mode(slice.Iter(data)); !ok || m != 4 {
+			t.Error("Not equal", m)
+		}
+
+		if m, ok := Mode(slice.Iter([]int{1, 2, 2, 1})); !ok || m != 1 {
+			t.Error("Not equal", m)
+		}
+	})
+
+	t.Run("Histogram", func(t *testing.T) {
+		if h := Histogram(slice.Iter(data), LinearBuckets(3, 2, 3)...); !reflect.DeepEqual(h, []int{1, 3, 2, 2}) {
+			t.Error("Not equal", h)
+		}
+	})
+
+	t.Run("Correlation", func(t *testing.T) {
+		var pairs = fp.Zip2(fp.Range(5), fp.Map[float64](fp.Range(5), func(i int) float64 { return float64(i) * -2 }))
+		if c, ok := Correlation(pairs); !ok || math.Abs(c+1) > 1e-12 {
+			t.Error("Not equal", c)
+		}
+
+		if c, ok := Covariance(fp.Zip2(fp.Range(3), fp.Range(3))); !ok || math.Abs(c-2.0/3) > 1e-12 {
+			t.Error("Not equal", c)
+		}
+	})
+
+	t.Run("EMA", func(t *testing.T) {
+		if s := fp.Slice(EMA(slice.Iter(slice.Of(10, 20, 20)), 0.5)); !reflect.DeepEqual(s, []float64{10, 15, 17.5}) {
+			t.Error("Not equal", s)
+		}
+	})
+}
@@ -0,0 +1,121 @@
+package stats
+
+import (
+	"math"
+	"sort"
+
+	"github.com/molikatty/fp"
+)
+
+type centroid struct {
+	mean, weight float64
+}
+
+// TDigest approximate quantiles of a stream in bounded memory, using the
+// merging t-digest of Dunning. The accuracy is best near the tails, and grows
+// with the compression, 100 is a common choice.
+//
+// Warning: not concurrent-safe.
+type TDigest struct {
+	compression float64
+	centroids   []centroid
+	buffer      []centroid
+	count       float64
+	min, max    float64
+}
+
+// NewTDigest make an empty digest with the compression, at most about
+// 'compression' centroids are kept.
+func NewTDigest(compression float64) *TDigest {
+	if compression < 1 {
+		panic(fp.ErrNotPositive)
+	}
+
+	return &TDigest{compression: compression, min: math.Inf(1), max: math.Inf(-1)}
+}
+
+// Add a number, NaN is ignored.
+func (t *TDigest) Add(x float64) {
+	if math.IsNaN(x) {
+		return
+	}
+
+	t.buffer = append(t.buffer, centroid{x, 1})
+	t.count++
+	t.min, t.max = math.Min(t.min, x), math.Max(t.max, x)
+	if len(t.buffer) >= int(t.compression)*4 {
+		t.compress()
+	}
+}
+
+// Count of the numbers added.
+func (t *TDigest) Count() int {
+	return int(t.count)
+}
+
+// Quantile the approximate q-quantile, 'q' is clamped to [0, 1], NaN if the
+// digest is empty.
+func (t *TDigest) Quantile(q float64) float64 {
+	t.compress()
+	if len(t.centroids) == 0 {
+		return math.NaN()
+	}
+
+	// interpolate between the centers of the centroids, the mass of a
+	// centroid is centered on its mean, min and max anchor both ends.
+	var target = math.Max(0, math.Min(1, q)) * t.count
+	var lastPos, lastMean, cum = 0.0, t.min, 0.0
+	for _, c := range t.centroids {
+		var pos = cum + c.weight/2
+		if target <= pos {
+			return interpolate(lastMean, c.mean, lastPos, pos, target)
+		}
+
+		cum += c.weight
+		lastPos, lastMean = pos, c.mean
+	}
+
+	return interpolate(lastMean, t.max, lastPos, t.count, target)
+}
+
+func interpolate(a, b, from, to, at float64) float64 {
+	return fp.If(to <= from, fp.Lazy(b), func() float64 {
+		return a + (b-a)*(at-from)/(to-from)
+	})
+}
+
+// compress merge the buffered numbers into the centroids.
+func (t *TDigest) compress() {
+	if len(t.buffer) == 0 {
+		return
+	}
+
+	var all = append(t.centroids, t.buffer...)
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+
+	var merged = []centroid{all[0]}
+	var before float64
+	for _, c := range all[1:] {
+		var cur = &merged[len(merged)-1]
+		var q = (before + cur.weight + c.weight/2) / t.count
+		if cur.weight+c.weight <= 4*t.count*q*(1-q)/t.compression {
+			cur.mean += (c.mean - cur.mean) * c.weight / (cur.weight + c.weight)
+			cur.weight += c.weight
+			continue
+		}
+
+		before += cur.weight
+		merged = append(merged, c)
+	}
+
+	t.centroids, t.buffer = merged, t.buffer[:0]
+}
+
+// ApproxQuantile the approximate q-quantile of the numbers with a TDigest of
+// the compression, false if the iterator is empty.
+func ApproxQuantile[N fp.Real](next fp.Next[N], q, compression float64) (float64, bool) {
+	var t = NewTDigest(compression)
+	fp.Loop(next, func(n N) { t.Add(float64(n)) })
+
+	return t.Quantile(q), t.Count() > 0
+}
@@ -0,0 +1,14 @@
+//go:build go1.23
+
+package str
+
+import (
+	"iter"
+
+	"github.com/molikatty/fp/slice"
+)
+
+// Seq create an iter.Seq for string.
+func Seq[C Char](s string) iter.Seq[C] {
+	return slice.Seq(chars[C](s))
+}
@@ -15,7 +15,11 @@ type Char interface {
 
 // Iter create an iterator for string.
 func Iter[C Char](s string) fp.Next[C] {
-	var bytes = fp.If(fp.Is[byte](fp.Zero[C]()),
+	return slice.Iter(chars[C](s))
+}
+
+func chars[C Char](s string) []C {
+	return fp.If(fp.Is[byte](fp.Zero[C]()),
 		func() []C {
 			return fp.To[[]C](To[byte](s))
 		},
@@ -23,8 +27,6 @@ func Iter[C Char](s string) fp.Next[C] {
 			return fp.To[[]C](To[rune](s))
 		},
 	)
-
-	return slice.Iter(bytes)
 }
 
 // Cat efficiently concatenate multiple strings.
@@ -91,7 +93,7 @@ func Hash[N uint32 | uint64](s string) N {
 		return fp.Any(murmur3.Sum64(To[byte](s)))
 	}
 
-	return fp.To[N](fp.If(fp.Is[uint32](fp.Zero[N]()), u32, u64))
+	return fp.AnyTo[N](fp.If(fp.Is[uint32](fp.Zero[N]()), u32, u64))
 }
 
 // Md5 conver a string to md5 string
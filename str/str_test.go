@@ -3,6 +3,8 @@ package str
 import (
 	"math/rand"
 	"testing"
+
+	"github.com/spaolacci/murmur3"
 )
 
 const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
@@ -19,6 +21,18 @@ func randomString(n int) []string {
 	return str
 }
 
+func TestExample(t *testing.T) {
+	t.Run("Hash", func(t *testing.T) {
+		if Hash[uint64]("a") == Hash[uint64]("b") || Hash[uint32]("a") == Hash[uint32]("b") {
+			t.Error("same hash for different strings")
+		}
+
+		if Hash[uint32]("fp") != murmur3.Sum32([]byte("fp")) {
+			t.Error("Not equal")
+		}
+	})
+}
+
 func BenchmarkExample(b *testing.B) {
 	var s = randomString(10)
 
@@ -0,0 +1,135 @@
+package stream
+
+// Package stream provides a fluent, chainable wrapper around fp.Next.
+//
+//	stream.Of(slice.Iter(users)).
+//		Filter(isActive).
+//		Skip(10).
+//		Take(10).
+//		Collect()
+import (
+	"github.com/molikatty/fp"
+)
+
+// Stream a chainable iterator, each method compiles to the fp combinator of
+// the same name. Like fp.Next, a stream can be consumed only once.
+type Stream[E any] fp.Next[E]
+
+// Of wrap an iterator into a stream.
+func Of[E any](next fp.Next[E]) Stream[E] {
+	return Stream[E](next)
+}
+
+// Values create a stream of values.
+func Values[E any](t ...E) Stream[E] {
+	var index = -1
+	return func() (E, bool) {
+		index++
+		if index >= len(t) {
+			return fp.Zero[E](), false
+		}
+
+		return t[index], true
+	}
+}
+
+// Map the values of the stream to new values, the type-changing form of
+// Stream.Map.
+// this function is lazy.
+func Map[R, E any](s Stream[E], fn func(E) R) Stream[R] {
+	return Of(fp.Map[R](s.Next(), fn))
+}
+
+// Next unwrap the stream into an iterator.
+func (s Stream[E]) Next() fp.Next[E] {
+	return fp.Next[E](s)
+}
+
+// Map the values to new values of the same type, see Map for other types.
+func (s Stream[E]) Map(fn func(E) E) Stream[E] {
+	return Map(s, fn)
+}
+
+// Filter keep only the values that evaluate to 'true'.
+func (s Stream[E]) Filter(fn func(E) bool) Stream[E] {
+	return Of(fp.Filter(s.Next(), fn))
+}
+
+// Take the first 'n' values.
+func (s Stream[E]) Take(n int) Stream[E] {
+	return Of(fp.Take(n, s.Next()))
+}
+
+// Limit same as Take.
+func (s Stream[E]) Limit(n int) Stream[E] {
+	return s.Take(n)
+}
+
+// Skip the first 'n' values.
+func (s Stream[E]) Skip(n int) Stream[E] {
+	return Of(fp.Skip(n, s.Next()))
+}
+
+// Peek call 'fn' on each value as it passes through.
+func (s Stream[E]) Peek(fn func(E)) Stream[E] {
+	return Of(fp.Peek(s.Next(), fn))
+}
+
+// Distinct keep only the first occurrence of each value.
+//
+// Warning: the values are compared as interface{}, it panics if the dynamic
+// type is not comparable.
+func (s Stream[E]) Distinct() Stream[E] {
+	return Of(fp.DistinctBy(s.Next(), fp.Any[E]))
+}
+
+// Sorted sort the values with 'less', the stream is materialized.
+func (s Stream[E]) Sorted(less func(E, E) bool) Stream[E] {
+	return Of(fp.Sorted(s.Next(), less))
+}
+
+// Collect the values into a slice.
+func (s Stream[E]) Collect() []E {
+	return fp.Slice(s.Next())
+}
+
+// Count the values.
+func (s Stream[E]) Count() int {
+	return fp.Count(s.Next())
+}
+
+// First get the first value, false if the stream is empty.
+func (s Stream[E]) First() (E, bool) {
+	return fp.First(s.Next())
+}
+
+// Reduce the values from left to right.
+func (s Stream[E]) Reduce(fn func(E, E) E) E {
+	return fp.Reduce(s.Next(), fn)
+}
+
+// ForEach loop through the values, stop when 'fn' returns false.
+func (s Stream[E]) ForEach(fn func(E) bool) {
+	fp.ForEach(s.Next(), fn)
+}
+
+// AnyMatch check if any value evaluates to 'true', stop at the first match.
+func (s Stream[E]) AnyMatch(fn func(E) bool) bool {
+	return fp.Single(fp.Map[bool](s.Next(), fn))
+}
+
+// AllMatch check if all values evaluate to 'true', stop at the first mismatch.
+// An empty stream matches.
+func (s Stream[E]) AllMatch(fn func(E) bool) bool {
+	return !s.AnyMatch(func(e E) bool { return !fn(e) })
+}
+
+// NoneMatch check if no value evaluates to 'true'.
+func (s Stream[E]) NoneMatch(fn func(E) bool) bool {
+	return !s.AnyMatch(fn)
+}
+
+// Collect the values of the stream with a collector, see fp.Collect.
+func Collect[E, A, R any](s Stream[E], c fp.Collector[E, A, R]) R {
+	return fp.Collect(s.Next(), c)
+}
@@ -0,0 +1,55 @@
+package stream
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/molikatty/fp"
+)
+
+func TestExample(t *testing.T) {
+	t.Run("Chain", func(t *testing.T) {
+		var s = Of(fp.Iota[int]()).
+			Filter(func(i int) bool { return i%2 == 0 }).
+			Skip(1).
+			Take(3).
+			Collect()
+
+		if !reflect.DeepEqual(s, []int{2, 4, 6}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Map", func(t *testing.T) {
+		var s = Map(Values(3, 1, 2).Sorted(func(a, b int) bool { return a < b }), strconv.Itoa).Collect()
+		if !reflect.DeepEqual(s, []string{"1", "2", "3"}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Distinct", func(t *testing.T) {
+		if n := Values(1, 2, 1, 3, 2).Distinct().Count(); n != 3 {
+			t.Error("Not equal", n)
+		}
+	})
+
+	t.Run("Match", func(t *testing.T) {
+		var even = func(i int) bool { return i%2 == 0 }
+		if !Values(1, 2, 3).AnyMatch(even) || Values(1, 2).AllMatch(even) || Values(2, 4).NoneMatch(even) {
+			t.Error("Not equal")
+		}
+
+		if !Values[int]().AllMatch(even) {
+			t.Error("empty stream should match")
+		}
+	})
+
+	t.Run("First", func(t *testing.T) {
+		var peeked []int
+		v, ok := Of(fp.Range(5, 10)).Peek(func(i int) { peeked = append(peeked, i) }).First()
+		if !ok || v != 5 || len(peeked) != 1 {
+			t.Error("Not equal", v, peeked)
+		}
+	})
+}
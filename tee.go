@@ -0,0 +1,154 @@
+package fp
+
+import "sync"
+
+type tee[E any] struct {
+	next  Next[E]
+	buf   []E
+	base  int
+	pos   []int
+	done  bool
+	limit int
+	cond  *sync.Cond
+}
+
+// Tee split the iterator into 'n' independent iterators, values are buffered
+// only until the slowest iterator has read them. An iterator that is never
+// read keeps every value in memory.
+// this function is lazy.
+//
+// Warning: the iterators are not concurrent-safe, see TeeLock.
+func Tee[E any](next Next[E], n int) []Next[E] {
+	var t = newTee(next, n, 0)
+	return tees(n, func(i int) Next[E] {
+		return func() (E, bool) {
+			e, ok, _ := t.get(i)
+			return e, ok
+		}
+	})
+}
+
+// TeeLock like Tee, but the iterators can be read from different goroutines.
+// this function is lazy.
+func TeeLock[E any](next Next[E], n int) []Next[E] {
+	var mu sync.Mutex
+	var ts = Tee(next, n)
+	return tees(n, func(i int) Next[E] {
+		return lock(&mu, ts[i])
+	})
+}
+
+// TeeBounded like Tee, but at most 'limit' values are buffered, an iterator
+// that runs 'limit' values ahead of the slowest one gets ErrLag, and can be
+// called again once the others catch up.
+// this function is lazy.
+func TeeBounded[E any](next Next[E], n, limit int) []TryNext[E] {
+	if limit < 1 {
+		panic(ErrNotPositive)
+	}
+
+	var t = newTee(next, n, limit)
+	return tees(n, func(i int) TryNext[E] {
+		return func() (E, bool, error) {
+			return t.get(i)
+		}
+	})
+}
+
+// TeeBlock like TeeLock, but at most 'limit' values are buffered, an iterator
+// that runs 'limit' values ahead of the slowest one blocks until the others
+// catch up.
+// this function is lazy.
+//
+// Warning: the iterators must be read from different goroutines, otherwise
+// a blocked iterator waits forever.
+func TeeBlock[E any](next Next[E], n, limit int) []Next[E] {
+	if limit < 1 {
+		panic(ErrNotPositive)
+	}
+
+	var mu sync.Mutex
+	var t = newTee(next, n, limit)
+	t.cond = sync.NewCond(&mu)
+	return tees(n, func(i int) Next[E] {
+		return lock(&mu, func() (E, bool) {
+			e, ok, _ := t.get(i)
+			return e, ok
+		})
+	})
+}
+
+func newTee[E any](next Next[E], n, limit int) *tee[E] {
+	if n < 1 {
+		panic(ErrNotPositive)
+	}
+
+	return &tee[E]{next: next, pos: make([]int, n), limit: limit}
+}
+
+func tees[T any](n int, fn func(int) T) []T {
+	var s = make([]T, n)
+	for i := range s {
+		s[i] = fn(i)
+	}
+
+	return s
+}
+
+func (t *tee[E]) get(i int) (E, bool, error) {
+	for {
+		if p := t.pos[i]; p < t.base+len(t.buf) {
+			var e = t.buf[p-t.base]
+			t.pos[i]++
+			t.trim()
+			return e, true, nil
+		}
+
+		if t.done {
+			return Zero[E](), false, nil
+		}
+
+		if t.limit > 0 && len(t.buf) >= t.limit {
+			if t.cond == nil {
+				return Zero[E](), false, ErrLag
+			}
+
+			t.cond.Wait()
+			continue
+		}
+
+		e, ok := t.next()
+		if Not(ok) {
+			t.done = true
+			t.broadcast()
+			continue
+		}
+
+		t.buf = append(t.buf, e)
+	}
+}
+
+// trim drop the values every iterator has read.
+func (t *tee[E]) trim() {
+	var low = t.pos[0]
+	for _, p := range t.pos[1:] {
+		if p < low {
+			low = p
+		}
+	}
+
+	if drop := low - t.base; drop > 0 {
+		for i := 0; i < drop; i++ {
+			t.buf[i] = Zero[E]()
+		}
+
+		t.buf, t.base = t.buf[drop:], low
+		t.broadcast()
+	}
+}
+
+func (t *tee[E]) broadcast() {
+	if t.cond != nil {
+		t.cond.Broadcast()
+	}
+}
@@ -0,0 +1,53 @@
+package fp
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTee(t *testing.T) {
+	t.Run("Tee", func(t *testing.T) {
+		var ts = Tee(Range(5), 2)
+		if s := Slice(Take(3, ts[0])); !reflect.DeepEqual(s, []int{0, 1, 2}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(ts[1]); !reflect.DeepEqual(s, []int{0, 1, 2, 3, 4}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(ts[0]); !reflect.DeepEqual(s, []int{3, 4}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("TeeBounded", func(t *testing.T) {
+		var ts = TeeBounded(Range(5), 2, 2)
+		if s, err := TrySlice(ts[0]); !errors.Is(err, ErrLag) || !reflect.DeepEqual(s, []int{0, 1}) {
+			t.Error("Not equal", s, err)
+		}
+
+		ts[1]()
+		if e, ok, err := ts[0](); err != nil || !ok || e != 2 {
+			t.Error("Not equal", e, err)
+		}
+	})
+
+	t.Run("TeeBlock", func(t *testing.T) {
+		noLeak(t, func() {
+			var ts = TeeBlock(Range(100), 3, 4)
+			var sums = make([]int, len(ts))
+			run, wait := Async().Expand()
+			for i := range ts {
+				i := i
+				run(func() { sums[i] = SumFrom(ts[i]) })
+			}
+
+			wait()
+			if !reflect.DeepEqual(sums, []int{4950, 4950, 4950}) {
+				t.Error("Not equal", sums)
+			}
+		})
+	})
+}
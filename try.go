@@ -0,0 +1,123 @@
+package fp
+
+// TryNext iterator for fallible sources such as I/O, an error also ends the
+// iteration.
+type TryNext[E any] func() (E, bool, error)
+
+// ToTry convert an iterator to a TryNext that never fails.
+// this function is lazy.
+func ToTry[E any](next Next[E]) TryNext[E] {
+	return func() (E, bool, error) {
+		e, ok := next()
+		return e, ok, nil
+	}
+}
+
+// FromTry convert a TryNext to an iterator, the iterator stops at the first
+// error, which can be obtained from the returned func once it is exhausted.
+// this function is lazy.
+//
+//	next, err := FromTry(rows).Expand()
+//	s := Slice(Map[string](next, fn))
+//	if err() != nil {
+//	  // handle error
+//	}
+func FromTry[E any](next TryNext[E]) Pairs[Next[E], func() error] {
+	var err error
+	return Pair(Next[E](func() (E, bool) {
+		if err != nil {
+			return Zero[E](), false
+		}
+
+		var e, ok, er = next()
+		err = er
+		return e, ok && er == nil
+	}), func() error { return err })
+}
+
+// TryMap map the values inside the iterator to new values, the first error of
+// the iterator or 'fn' stops the iteration.
+// this function is lazy.
+func TryMap[ER, E any](next TryNext[E], fn func(E) (ER, error)) TryNext[ER] {
+	return func() (ER, bool, error) {
+		t, ok, err := next()
+		if err != nil || Not(ok) {
+			return Zero[ER](), false, err
+		}
+
+		r, err := fn(t)
+		if err != nil {
+			return Zero[ER](), false, err
+		}
+
+		return r, true, nil
+	}
+}
+
+// TryFilter keep only the values that evaluate to 'true', the first error of
+// the iterator or 'fn' stops the iteration.
+// this function is lazy.
+func TryFilter[E any](next TryNext[E], fn func(E) (bool, error)) TryNext[E] {
+	return func() (E, bool, error) {
+		for {
+			t, ok, err := next()
+			if err != nil || Not(ok) {
+				return Zero[E](), false, err
+			}
+
+			keep, err := fn(t)
+			if err != nil {
+				return Zero[E](), false, err
+			}
+
+			if keep {
+				return t, true, nil
+			}
+		}
+	}
+}
+
+// TryForEach like ForEach, return the first error of the iterator.
+func TryForEach[E any](next TryNext[E], fn func(E) bool) error {
+	for {
+		t, ok, err := next()
+		if err != nil || Not(ok) {
+			return err
+		}
+
+		if Not(fn(t)) {
+			return nil
+		}
+	}
+}
+
+// TryReduce like Reduce, return the first error of the iterator or 'fn'
+// along with the value reduced so far.
+func TryReduce[E any](next TryNext[E], fn func(E, E) (E, error)) (E, error) {
+	var poly E
+	for {
+		t, ok, err := next()
+		if err != nil || Not(ok) {
+			return poly, err
+		}
+
+		r, err := fn(poly, t)
+		if err != nil {
+			return poly, err
+		}
+
+		poly = r
+	}
+}
+
+// TrySlice generate a slice from a TryNext, return the values collected so
+// far and the first error.
+func TrySlice[E any](next TryNext[E]) ([]E, error) {
+	var slice = make([]E, 0)
+	var err = TryForEach(next, func(e E) bool {
+		slice = append(slice, e)
+		return true
+	})
+
+	return slice, err
+}
@@ -0,0 +1,61 @@
+package fp
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTry(t *testing.T) {
+	t.Run("TryMap", func(t *testing.T) {
+		var fail = errors.New("fail")
+		var next = TryMap[int](ToTry(Range(10)), func(i int) (int, error) {
+			if i == 3 {
+				return 0, fail
+			}
+
+			return i * 2, nil
+		})
+
+		s, err := TrySlice(next)
+		if !errors.Is(err, fail) || !reflect.DeepEqual(s, []int{0, 2, 4}) {
+			t.Error("Not equal", s, err)
+		}
+	})
+
+	t.Run("TryFilter", func(t *testing.T) {
+		var next = TryFilter(ToTry(Range(6)), func(i int) (bool, error) {
+			return i%2 == 0, nil
+		})
+
+		n, err := TryReduce(next, func(a, b int) (int, error) {
+			return a + b, nil
+		})
+
+		if err != nil || n != 6 {
+			t.Error("Not equal", n, err)
+		}
+
+		var fail = errors.New("fail")
+		n, err = TryReduce(ToTry(Range(1, 10)), func(a, b int) (int, error) {
+			return a + b, If(b == 4, Lazy(fail), Zero[error])
+		})
+
+		if !errors.Is(err, fail) || n != 6 {
+			t.Error("Not equal", n, err)
+		}
+	})
+
+	t.Run("FromTry", func(t *testing.T) {
+		var fail = errors.New("fail")
+		var i int
+		next, errf := FromTry(TryNext[int](func() (int, bool, error) {
+			i++
+			return i, true, If(i == 3, Lazy(fail), Zero[error])
+		})).Expand()
+
+		if s := Slice(next); !reflect.DeepEqual(s, []int{1, 2}) || !errors.Is(errf(), fail) {
+			t.Error("Not equal", s, errf())
+		}
+	})
+}
@@ -6,12 +6,14 @@ import (
 )
 
 type (
-	FAny   = func(any) any
-	FAnys  = func(...any) any
-	None   = struct{}
-	Run    = func(func())
-	RunCtx = func(func(context.Context))
-	Wait   = func()
+	FAny     = func(any) any
+	FAnys    = func(...any) any
+	FAnysErr = func(...any) (any, error)
+	None     = struct{}
+	Run      = func(func())
+	RunCtx   = func(func(context.Context))
+	Wait     = func()
+	Close    = func()
 
 	// Generic collection of signed numbers
 	Signed interface {
@@ -33,6 +35,11 @@ type (
 		~float32 | ~float64
 	}
 
+	// Generic collection of real numbers, numbers that are ordered
+	Real interface {
+		Integer | Float
+	}
+
 	// Generic collection of all numeric types
 	Number interface {
 		Integer | Float | ~complex64 | ~complex128
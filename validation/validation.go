@@ -0,0 +1,201 @@
+// Package validation runs independent checks and reports all the failures,
+// not only the first one, each with the path of the field that failed.
+//
+//	server := validation.Validate2(
+//		validation.Field("host", c.Host, validation.NotEmpty),
+//		validation.Field("port", c.Port, validation.Between(1, 65535)),
+//		func(host string, port int) Server { return Server{host, port} },
+//	)
+//	s, err := server.Get() // err: "host: must not be empty\nport: must be between 1 and 65535"
+package validation
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/molikatty/fp"
+)
+
+// Validation a value with the errors found while validating it, the value is
+// usable only if there is no error.
+type Validation[T any] struct {
+	v    T
+	errs []error
+}
+
+// Validator check a value, nil if it is valid.
+type Validator[T any] func(T) error
+
+// FieldError an error of the field at Path, such as "servers[0].port".
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Valid a validation without error.
+func Valid[T any](t T) Validation[T] {
+	return Validation[T]{v: t}
+}
+
+// Invalid a validation with errors, nil errors are ignored.
+func Invalid[T any](errs ...error) Validation[T] {
+	return Validation[T]{errs: compact(errs)}
+}
+
+// Of run the validators on 't', all of them are run.
+func Of[T any](t T, validators ...Validator[T]) Validation[T] {
+	var errs = make([]error, 0)
+	for _, validate := range validators {
+		errs = append(errs, validate(t))
+	}
+
+	return Validation[T]{t, compact(errs)}
+}
+
+// Field like Of, with the errors at the path of the field.
+func Field[T any](path string, t T, validators ...Validator[T]) Validation[T] {
+	return Of(t, validators...).At(path)
+}
+
+// Map the value of a valid validation.
+func Map[R, T any](v Validation[T], fn func(T) R) Validation[R] {
+	return fp.If(v.IsValid(), func() Validation[R] { return Valid(fn(v.v)) }, func() Validation[R] {
+		return Invalid[R](v.errs...)
+	})
+}
+
+// Validate2 combine two independent validations, 'fn' builds the result only
+// if both are valid, otherwise the errors of both are kept.
+func Validate2[R, A, B any](a Validation[A], b Validation[B], fn func(A, B) R) Validation[R] {
+	if errs := join(a.errs, b.errs); len(errs) > 0 {
+		return Invalid[R](errs...)
+	}
+
+	return Valid(fn(a.v, b.v))
+}
+
+// Validate3 like Validate2, for three validations.
+func Validate3[R, A, B, C any](a Validation[A], b Validation[B], c Validation[C], fn func(A, B, C) R) Validation[R] {
+	if errs := join(a.errs, b.errs, c.errs); len(errs) > 0 {
+		return Invalid[R](errs...)
+	}
+
+	return Valid(fn(a.v, b.v, c.v))
+}
+
+// Validate4 like Validate2, for four validations.
+func Validate4[R, A, B, C, D any](a Validation[A], b Validation[B], c Validation[C], d Validation[D], fn func(A, B, C, D) R) Validation[R] {
+	if errs := join(a.errs, b.errs, c.errs, d.errs); len(errs) > 0 {
+		return Invalid[R](errs...)
+	}
+
+	return Valid(fn(a.v, b.v, c.v, d.v))
+}
+
+// Validate5 like Validate2, for five validations.
+func Validate5[R, A, B, C, D, E any](a Validation[A], b Validation[B], c Validation[C], d Validation[D], e Validation[E], fn func(A, B, C, D, E) R) Validation[R] {
+	if errs := join(a.errs, b.errs, c.errs, d.errs, e.errs); len(errs) > 0 {
+		return Invalid[R](errs...)
+	}
+
+	return Valid(fn(a.v, b.v, c.v, d.v, e.v))
+}
+
+// All validate every value of a slice, the errors are at the index of the
+// value, such as "[2].port".
+func All[T any](ts []T, validate func(T) Validation[T]) Validation[[]T] {
+	var errs = make([]error, 0)
+	for i := range ts {
+		errs = append(errs, validate(ts[i]).at(index(i)).errs...)
+	}
+
+	return Validation[[]T]{ts, errs}
+}
+
+// IsValid check there is no error.
+func (v Validation[T]) IsValid() bool {
+	return len(v.errs) == 0
+}
+
+// Errors of the validation, each a *FieldError if it has a path.
+func (v Validation[T]) Errors() []error {
+	return v.errs
+}
+
+// Get the value and the errors joined with errors.Join, nil if it is valid.
+func (v Validation[T]) Get() (T, error) {
+	return v.v, errors.Join(v.errs...)
+}
+
+// At put the errors at the path of a field, nested paths are joined with '.'.
+func (v Validation[T]) At(path string) Validation[T] {
+	return v.at(func(inner string) string {
+		return fp.If(inner == "", fp.Lazy(path), func() string {
+			return path + fp.If(inner[0] == '[', fp.Zero[string], fp.Lazy(".")) + inner
+		})
+	})
+}
+
+func (v Validation[T]) at(prefix func(string) string) Validation[T] {
+	var errs = make([]error, len(v.errs))
+	for i, err := range v.errs {
+		errs[i] = withPath(err, prefix)
+	}
+
+	v.errs = errs
+	return v
+}
+
+func index(i int) func(string) string {
+	return func(inner string) string {
+		return "[" + strconv.Itoa(i) + "]" + fp.If(inner == "" || inner[0] == '[', fp.Zero[string], fp.Lazy(".")) + inner
+	}
+}
+
+// withPath prefix the path of a *FieldError, or make one, the errors joined by
+// errors.Join are prefixed one by one.
+func withPath(err error, prefix func(string) string) error {
+	if field, ok := err.(*FieldError); ok {
+		return &FieldError{prefix(field.Path), field.Err}
+	}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var errs = joined.Unwrap()
+		var wrapped = make([]error, len(errs))
+		for i := range errs {
+			wrapped[i] = withPath(errs[i], prefix)
+		}
+
+		return errors.Join(wrapped...)
+	}
+
+	return &FieldError{prefix(""), err}
+}
+
+func join(errs ...[]error) []error {
+	var all = make([]error, 0)
+	for i := range errs {
+		all = append(all, errs[i]...)
+	}
+
+	return all
+}
+
+func compact(errs []error) []error {
+	var s = make([]error, 0, len(errs))
+	for i := range errs {
+		if errs[i] != nil {
+			s = append(s, errs[i])
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,132 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+type server struct {
+	host string
+	port int
+}
+
+func newServer(host string, port int) server {
+	return server{host, port}
+}
+
+func paths(errs []error) []string {
+	var s []string
+	for _, err := range errs {
+		var field *FieldError
+		if errors.As(err, &field) {
+			s = append(s, field.Path)
+		}
+	}
+
+	return s
+}
+
+func TestExample(t *testing.T) {
+	t.Run("Validate2", func(t *testing.T) {
+		var v = Validate2(
+			Field("host", "localhost", NotEmpty),
+			Field("port", 8080, Between(1, 65535)),
+			newServer,
+		)
+		if s, err := v.Get(); err != nil || s != newServer("localhost", 8080) {
+			t.Error("Not equal")
+		}
+	})
+
+	t.Run("Validate2Errors", func(t *testing.T) {
+		var v = Validate2(
+			Field("host", "", NotEmpty),
+			Field("port", 0, Between(1, 65535)),
+			newServer,
+		)
+		var _, err = v.Get()
+		if v.IsValid() || err.Error() != "host: must not be empty\nport: must be between 1 and 65535" {
+			t.Error("Not equal", err)
+		}
+	})
+
+	t.Run("Validate3", func(t *testing.T) {
+		var v = Validate3(
+			Field("a", 1, Positive[int]),
+			Field("b", -1, Positive[int]),
+			Field("c", "", NotEmpty, MinLen(1)),
+			func(a, b int, c string) int { return a + b },
+		)
+		if !reflect.DeepEqual(paths(v.Errors()), []string{"b", "c", "c"}) {
+			t.Error("Not equal", v.Errors())
+		}
+	})
+
+	t.Run("Nested", func(t *testing.T) {
+		var servers = []server{{"a", 80}, {"", 0}, {"c", 70000}}
+		var v = All(servers, func(s server) Validation[server] {
+			return Validate2(
+				Field("host", s.host, NotEmpty),
+				Field("port", s.port, Between(1, 65535)),
+				newServer,
+			)
+		}).At("servers")
+
+		var want = []string{"servers[1].host", "servers[1].port", "servers[2].port"}
+		if !reflect.DeepEqual(paths(v.Errors()), want) {
+			t.Error("Not equal", v.Errors())
+		}
+	})
+
+	t.Run("Each", func(t *testing.T) {
+		var v = Field("ports", []int{80, 0, 443}, MinItems[int](1), Each(Between(1, 65535)))
+		var _, err = v.Get()
+		if err == nil || err.Error() != "ports[1]: must be between 1 and 65535" {
+			t.Error("Not equal", err)
+		}
+	})
+
+	t.Run("Map", func(t *testing.T) {
+		var v = Map(Of(2, Positive[int]), func(n int) int { return n * 2 })
+		if n, err := v.Get(); err != nil || n != 4 {
+			t.Error("Not equal")
+		}
+
+		if Map(Of(-2, Positive[int]), func(n int) int { return n * 2 }).IsValid() {
+			t.Error("Not equal")
+		}
+	})
+
+	t.Run("Unwrap", func(t *testing.T) {
+		var sentinel = errors.New("sentinel")
+		var _, err = Field("a", 0, func(int) error { return sentinel }).Get()
+		if !errors.Is(err, sentinel) {
+			t.Error("Not equal")
+		}
+	})
+
+	t.Run("Validators", func(t *testing.T) {
+		var cases = []error{
+			Min(2)(1),
+			Max(2)(3),
+			NotZero(0),
+			OneOf("a", "b")("c"),
+			MaxLen(2)("héé"),
+			Contains("@")("a"),
+			Matches(regexp.MustCompile(`^\d+$`))("a1"),
+			MaxItems[int](1)([]int{1, 2}),
+			Unique([]int{1, 1}),
+		}
+		for i, err := range cases {
+			if err == nil {
+				t.Error("Not equal", i)
+			}
+		}
+
+		if MinLen(3)("héé") != nil || OneOf("a", "b")("a") != nil || Unique([]int{1, 2}) != nil {
+			t.Error("Not equal")
+		}
+	})
+}
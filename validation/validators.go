@@ -0,0 +1,97 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/molikatty/fp"
+	"github.com/molikatty/fp/slice"
+	"github.com/molikatty/fp/str"
+)
+
+// Min check the value is at least 'min'.
+func Min[N fp.Size](min N) Validator[N] {
+	return check(func(n N) bool { return n >= min }, "must be at least %v", min)
+}
+
+// Max check the value is at most 'max'.
+func Max[N fp.Size](max N) Validator[N] {
+	return check(func(n N) bool { return n <= max }, "must be at most %v", max)
+}
+
+// Between check the value is in [min, max].
+func Between[N fp.Size](min, max N) Validator[N] {
+	return check(func(n N) bool { return n >= min && n <= max }, "must be between %v and %v", min, max)
+}
+
+// Positive check the number is greater than zero.
+func Positive[N fp.Real](n N) error {
+	return check(func(n N) bool { return n > 0 }, "must be positive")(n)
+}
+
+// NotZero check the value is not the zero value.
+func NotZero[T comparable](t T) error {
+	return check(fp.NotZero[T], "must not be zero")(t)
+}
+
+// OneOf check the value is one of 'ts'.
+func OneOf[T comparable](ts ...T) Validator[T] {
+	return check(func(t T) bool { return slice.Contains(ts, t) }, "must be one of %v", ts)
+}
+
+// NotEmpty check the string is not empty.
+func NotEmpty(s string) error {
+	return check(func(s string) bool { return !str.IsEmpty(s) }, "must not be empty")(s)
+}
+
+// MinLen check the string has at least 'n' characters.
+func MinLen(n int) Validator[string] {
+	return check(func(s string) bool { return len(str.To[rune](s)) >= n }, "must have at least %d characters", n)
+}
+
+// MaxLen check the string has at most 'n' characters.
+func MaxLen(n int) Validator[string] {
+	return check(func(s string) bool { return len(str.To[rune](s)) <= n }, "must have at most %d characters", n)
+}
+
+// Contains check the string contains 'sub'.
+func Contains(sub string) Validator[string] {
+	return check(func(s string) bool { return strings.Contains(s, sub) }, "must contain %q", sub)
+}
+
+// Matches check the string matches the regular expression.
+func Matches(re *regexp.Regexp) Validator[string] {
+	return check(re.MatchString, "must match %s", re)
+}
+
+// MinItems check the slice has at least 'n' values.
+func MinItems[T any](n int) Validator[[]T] {
+	return check(func(ts []T) bool { return len(ts) >= n }, "must have at least %d items", n)
+}
+
+// MaxItems check the slice has at most 'n' values.
+func MaxItems[T any](n int) Validator[[]T] {
+	return check(func(ts []T) bool { return len(ts) <= n }, "must have at most %d items", n)
+}
+
+// Unique check the slice has no duplicate value.
+func Unique[T comparable](ts []T) error {
+	return check(func(ts []T) bool { return fp.Count(fp.Distinct(slice.Iter(ts))) == len(ts) }, "must not have duplicates")(ts)
+}
+
+// Each run the validators on every value of the slice, the errors are at the
+// index of the value.
+func Each[T any](validators ...Validator[T]) Validator[[]T] {
+	return func(ts []T) error {
+		_, err := All(ts, func(t T) Validation[T] { return Of(t, validators...) }).Get()
+		return err
+	}
+}
+
+func check[T any](ok func(T) bool, format string, args ...any) Validator[T] {
+	var err = fmt.Errorf(format, args...)
+	return func(t T) error {
+		return fp.If(ok(t), fp.Zero[error], fp.Lazy(err))
+	}
+}
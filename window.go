@@ -0,0 +1,84 @@
+package fp
+
+// Chunk split the iterator into slices of 'n' values, the last chunk may be
+// shorter. Panics if 'n' is not positive.
+// this function is lazy.
+func Chunk[E any](next Next[E], n int) Next[[]E] {
+	if n < 1 {
+		panic(ErrNotPositive)
+	}
+
+	return func() ([]E, bool) {
+		var chunk = Slice(Take(n, next))
+		return chunk, len(chunk) > 0
+	}
+}
+
+// Window sliding windows of 'size' values, a new window starts every 'step'
+// values, only full windows are yielded. Panics if 'size' or 'step' is not
+// positive.
+// this function is lazy.
+//
+//	Window(Range(5), 3, 1) -> [0 1 2] -> [1 2 3] -> [2 3 4]
+//	Window(Range(5), 2, 3) -> [0 1] -> [3 4]
+func Window[E any](next Next[E], size, step int) Next[[]E] {
+	return Map[[]E](WindowReuse(next, size, step), func(w []E) []E {
+		return append(make([]E, 0, size), w...)
+	})
+}
+
+// WindowReuse like Window, but the windows are views of a ring buffer that is
+// reused, a window is only valid until the next call of the iterator. No
+// allocation is made after the first window.
+// this function is lazy.
+func WindowReuse[E any](next Next[E], size, step int) Next[[]E] {
+	if size < 1 || step < 1 {
+		panic(ErrNotPositive)
+	}
+
+	// every value is stored twice, so the last 'size' values are always
+	// contiguous at ring[pos:pos+size].
+	var ring = make([]E, size<<1)
+	var pos, want = 0, size
+	return func() ([]E, bool) {
+		for ; want > 0; want-- {
+			e, ok := next()
+			if Not(ok) {
+				return nil, false
+			}
+
+			ring[pos], ring[pos+size] = e, e
+			pos = (pos + 1) % size
+		}
+
+		want = step
+		return ring[pos : pos+size], true
+	}
+}
+
+// Pairwise successive overlapping pairs of the iterator.
+// this function is lazy.
+//
+//	Pairwise(Range(4)) -> (0, 1) -> (1, 2) -> (2, 3)
+func Pairwise[E any](next Next[E]) Next[Pairs[E, E]] {
+	var prev, started = Zero[E](), false
+	return func() (Pairs[E, E], bool) {
+		if Not(started) {
+			var ok bool
+			if prev, ok = next(); Not(ok) {
+				return Zero[Pairs[E, E]](), false
+			}
+
+			started = true
+		}
+
+		e, ok := next()
+		if Not(ok) {
+			return Zero[Pairs[E, E]](), false
+		}
+
+		var p = Pair(prev, e)
+		prev = e
+		return p, true
+	}
+}
@@ -0,0 +1,39 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWindow(t *testing.T) {
+	t.Run("Chunk", func(t *testing.T) {
+		if s := Slice(Chunk(Range(5), 2)); !reflect.DeepEqual(s, [][]int{{0, 1}, {2, 3}, {4}}) {
+			t.Error("Not equal", s)
+		}
+	})
+
+	t.Run("Window", func(t *testing.T) {
+		if s := Slice(Window(Range(5), 3, 1)); !reflect.DeepEqual(s, [][]int{{0, 1, 2}, {1, 2, 3}, {2, 3, 4}}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(Window(Range(6), 2, 3)); !reflect.DeepEqual(s, [][]int{{0, 1}, {3, 4}}) {
+			t.Error("Not equal", s)
+		}
+
+		var sums = Slice(Map[int](WindowReuse(Range(6), 3, 2), func(w []int) int { return Sum(w...) }))
+		if !reflect.DeepEqual(sums, []int{3, 9}) {
+			t.Error("Not equal", sums)
+		}
+	})
+
+	t.Run("Pairwise", func(t *testing.T) {
+		if s := Slice(Pairwise(Range(4))); !reflect.DeepEqual(s, []Pairs[int, int]{Pair(0, 1), Pair(1, 2), Pair(2, 3)}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(Pairwise(Range(1))); len(s) != 0 {
+			t.Error("Not equal", s)
+		}
+	})
+}
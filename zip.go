@@ -0,0 +1,88 @@
+package fp
+
+// ZipWith combine the values at the same position of two iterators with 'fn',
+// stop when either iterator is exhausted.
+// this function is lazy.
+func ZipWith[R, A, B any](a Next[A], b Next[B], fn func(A, B) R) Next[R] {
+	return func() (R, bool) {
+		ea, ok := a()
+		if Not(ok) {
+			return Zero[R](), false
+		}
+
+		eb, ok := b()
+		if Not(ok) {
+			return Zero[R](), false
+		}
+
+		return fn(ea, eb), true
+	}
+}
+
+// Zip2 pair the values at the same position of two iterators of different
+// types, stop when either iterator is exhausted.
+// this function is lazy.
+func Zip2[A, B any](a Next[A], b Next[B]) Next[Pairs[A, B]] {
+	return ZipWith(a, b, Pair[A, B])
+}
+
+// Zip3 like Zip2, for three iterators.
+// this function is lazy.
+func Zip3[A, B, C any](a Next[A], b Next[B], c Next[C]) Next[Triples[A, B, C]] {
+	return ZipWith(Zip2(a, b), c, func(p Pairs[A, B], ec C) Triples[A, B, C] {
+		return Triple(p.Key(), p.Value(), ec)
+	})
+}
+
+// ZipLongest like Zip2, but stop when both iterators are exhausted, the
+// missing values of the shorter one are 'fa' or 'fb'.
+// this function is lazy.
+func ZipLongest[A, B any](a Next[A], b Next[B], fa A, fb B) Next[Pairs[A, B]] {
+	return func() (Pairs[A, B], bool) {
+		ea, oka := a()
+		eb, okb := b()
+		if Not(oka || okb) {
+			return Zero[Pairs[A, B]](), false
+		}
+
+		return Pair(If(oka, Lazy(ea), Lazy(fa)), If(okb, Lazy(eb), Lazy(fb))), true
+	}
+}
+
+// Unzip split an iterator of pairs into an iterator of keys and an iterator of
+// values, either can be read first, the values not yet read by the other are
+// buffered.
+// this function is lazy.
+func Unzip[A, B any](next Next[Pairs[A, B]]) (Next[A], Next[B]) {
+	var qa, qb = make([]A, 0), make([]B, 0)
+	var pull = func() bool {
+		p, ok := next()
+		if ok {
+			qa, qb = append(qa, p.Key()), append(qb, p.Value())
+		}
+
+		return ok
+	}
+
+	return func() (A, bool) {
+			if len(qa) == 0 && Not(pull()) {
+				return Zero[A](), false
+			}
+
+			return shift(&qa), true
+		}, func() (B, bool) {
+			if len(qb) == 0 && Not(pull()) {
+				return Zero[B](), false
+			}
+
+			return shift(&qb), true
+		}
+}
+
+// shift remove and return the first value of a non-empty queue.
+func shift[E any](q *[]E) E {
+	var e = (*q)[0]
+	(*q)[0] = Zero[E]()
+	*q = (*q)[1:]
+	return e
+}
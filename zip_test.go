@@ -0,0 +1,36 @@
+package fp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	t.Run("Zip2", func(t *testing.T) {
+		var s = Slice(Zip2(Range(3), iterate([]string{"a", "b"})))
+		if !reflect.DeepEqual(s, []Pairs[int, string]{Pair(0, "a"), Pair(1, "b")}) {
+			t.Error("Not equal", s)
+		}
+
+		var l = Slice(ZipLongest(Range(3), iterate([]string{"a"}), -1, "-"))
+		if !reflect.DeepEqual(l, []Pairs[int, string]{Pair(0, "a"), Pair(1, "-"), Pair(2, "-")}) {
+			t.Error("Not equal", l)
+		}
+
+		var z = Slice(Zip3(Range(2), Range(10, 12), iterate([]bool{true, false})))
+		if !reflect.DeepEqual(z, []Triples[int, int, bool]{Triple(0, 10, true), Triple(1, 11, false)}) {
+			t.Error("Not equal", z)
+		}
+	})
+
+	t.Run("Unzip", func(t *testing.T) {
+		keys, values := Unzip(Zip2(Range(3), iterate([]string{"a", "b", "c"})))
+		if s := Slice(values); !reflect.DeepEqual(s, []string{"a", "b", "c"}) {
+			t.Error("Not equal", s)
+		}
+
+		if s := Slice(keys); !reflect.DeepEqual(s, []int{0, 1, 2}) {
+			t.Error("Not equal", s)
+		}
+	})
+}